@@ -0,0 +1,304 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"wordgame/internal/game"
+
+	"github.com/fasthttp/websocket"
+)
+
+// state 는 서버가 보내는 게임 상태 중 시뮬레이션에 필요한 부분이다.
+type state struct {
+	LastWord    string            `json:"lastWord"`
+	Players     []game.PlayerInfo `json:"players"`
+	Spectators  []game.PlayerInfo `json:"spectators"`
+	CurrentTurn string            `json:"currentTurnPlayerId"`
+	HostUserID  string            `json:"hostUserId"`
+	IsGameOver  bool              `json:"isGameOver"`
+	IsStarted   bool              `json:"isStarted"`
+}
+
+// Room 은 한 방의 클라이언트들이 함께 쓰는 정보다. 브로드캐스트가 모두에게 닿는 시간을 잰다.
+type Room struct {
+	ID    int
+	stats *Stats
+
+	mu      sync.Mutex
+	members int
+	sent    map[string]*delivery
+}
+
+type delivery struct {
+	at       time.Time
+	received int
+}
+
+func NewRoom(id int, stats *Stats) *Room {
+	return &Room{ID: id, stats: stats, sent: make(map[string]*delivery)}
+}
+
+func (r *Room) join() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.members++
+}
+
+func (r *Room) leave() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.members--
+}
+
+func (r *Room) memberCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.members
+}
+
+func (r *Room) markSent(word string, at time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sent[word] = &delivery{at: at}
+}
+
+// markReceived 는 접속자 한 명이 word 가 마지막 단어인 상태를 받았음을 남긴다.
+// 방의 모든 접속자가 받으면 보낸 때부터의 시간을 fan-out 으로 기록한다.
+func (r *Room) markReceived(word string, at time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	d, ok := r.sent[word]
+	if !ok {
+		return
+	}
+	d.received++
+	if d.received >= r.members {
+		r.stats.Fanout(at.Sub(d.at))
+		delete(r.sent, word)
+	}
+}
+
+// Client 는 사람처럼 방에 들어와 자기 차례에 단어를 내는 가상 접속자다.
+type Client struct {
+	name     string
+	room     *Room
+	stats    *Stats
+	picker   *WordPicker
+	think    time.Duration
+	deadline time.Time
+
+	conn    *websocket.Conn
+	tag     string // 상태에서 자신을 가리키는 공개 ID
+	writeMu sync.Mutex
+	closing atomic.Bool
+
+	mu          sync.Mutex
+	pendingWord string
+	pendingAt   time.Time
+
+	// 아래는 읽기 고루틴만 쓴다.
+	prev      state
+	used      map[string]bool
+	readySent bool
+	startSent bool
+}
+
+// Connect 는 방에 접속해 환영 메시지를 받을 때까지 기다린다. 거절 알림을 받으면 실패다.
+func (c *Client) Connect(addr string, timeout time.Duration) error {
+	c.stats.Attempt()
+	begin := time.Now()
+	u := fmt.Sprintf("ws://%s/ws/%d?name=%s&lang=ko", addr, c.room.ID, url.QueryEscape(c.name))
+	dialer := websocket.Dialer{HandshakeTimeout: timeout}
+	conn, _, err := dialer.Dial(u, nil)
+	if err != nil {
+		c.stats.Error(ErrDial)
+		return err
+	}
+
+	_ = conn.SetReadDeadline(begin.Add(timeout))
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			c.stats.Error(ErrDial)
+			_ = conn.Close()
+			return err
+		}
+		var notice game.NoticeMessage
+		if json.Unmarshal(msg, &notice) != nil {
+			continue
+		}
+		switch notice.Type {
+		case game.WELCOMEJSONTYPE:
+			var welcome game.WelcomeMessage
+			_ = json.Unmarshal(msg, &welcome)
+			_ = conn.SetReadDeadline(time.Time{})
+			c.conn, c.tag = conn, welcome.YourTag
+			c.room.join()
+			c.stats.Connected(time.Since(begin))
+			return nil
+		case game.ERRORJSONTYPE:
+			c.stats.Error(ErrRejected)
+			_ = conn.Close()
+			return fmt.Errorf("rejected: %s", notice.Code)
+		}
+	}
+}
+
+// Run 은 연결이 닫힐 때까지 메시지를 읽고 상태에 맞춰 움직인다.
+func (c *Client) Run() {
+	defer c.room.leave()
+	for {
+		_, msg, err := c.conn.ReadMessage()
+		if err != nil {
+			if !c.closing.Load() {
+				c.stats.Error(ErrRead)
+			}
+			return
+		}
+		c.handle(msg, time.Now())
+	}
+}
+
+func (c *Client) handle(msg []byte, now time.Time) {
+	var typed struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(msg, &typed); err != nil {
+		c.stats.Error(ErrBadResponse)
+		return
+	}
+	if typed.Type != "" {
+		if typed.Type == game.ERRORJSONTYPE {
+			c.stats.Error(ErrServer)
+		}
+		return
+	}
+	var s state
+	if err := json.Unmarshal(msg, &s); err != nil {
+		c.stats.Error(ErrBadResponse)
+		return
+	}
+
+	if s.LastWord != "" && s.LastWord != c.prev.LastWord {
+		c.room.markReceived(s.LastWord, now)
+	}
+	c.resolvePending(s, now)
+
+	isHost := s.HostUserID == c.tag
+	if s.IsStarted && !c.prev.IsStarted {
+		c.used = make(map[string]bool)
+		c.readySent = false
+		c.startSent = false
+		if isHost {
+			c.stats.GameStarted()
+		}
+	}
+	if s.IsGameOver && !c.prev.IsGameOver && isHost {
+		c.stats.GameFinished()
+	}
+	if s.IsStarted && s.LastWord != "" {
+		c.used[s.LastWord] = true
+	}
+
+	active := now.Before(c.deadline)
+	switch {
+	case active && !s.IsStarted && !c.readySent && c.isPlayer(s) && !c.isReady(s):
+		c.readySent = true
+		c.send(game.TOGGLEREADYJSONTYPE, nil)
+	case active && isHost && !s.IsStarted && !s.IsGameOver && !c.startSent && allReady(s.Players) &&
+		len(s.Players) >= 2 && len(s.Players)+len(s.Spectators) >= c.room.memberCount():
+		c.startSent = true
+		c.send(game.STARTJSONTYPE, nil)
+	case active && s.IsStarted && !s.IsGameOver && s.CurrentTurn == c.tag && c.isPlayer(s) &&
+		(c.prev.CurrentTurn != c.tag || c.prev.LastWord != s.LastWord):
+		word := c.picker.Next(s.LastWord, c.used)
+		time.AfterFunc(c.think, func() { c.submit(word) })
+	}
+	c.prev = s
+}
+
+// resolvePending 은 낸 단어의 결과가 담긴 상태인지 보고 지연 시간을 기록한다.
+// 단어가 마지막 단어가 되었으면 받아들여진 것이고, 차례가 넘어가거나 탈락했거나 게임이 끝났으면 거절된 것이다.
+func (c *Client) resolvePending(s state, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.pendingWord == "" {
+		return
+	}
+	accepted := s.LastWord == c.pendingWord
+	if accepted || s.CurrentTurn != c.tag || !c.isPlayer(s) || s.IsGameOver {
+		c.stats.Latency(now.Sub(c.pendingAt), accepted)
+		c.pendingWord = ""
+	}
+}
+
+func (c *Client) isPlayer(s state) bool {
+	for _, p := range s.Players {
+		if p.ID == c.tag {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Client) isReady(s state) bool {
+	for _, p := range s.Players {
+		if p.ID == c.tag {
+			return p.Ready
+		}
+	}
+	return false
+}
+
+func allReady(players []game.PlayerInfo) bool {
+	for _, p := range players {
+		if !p.Ready {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Client) submit(word string) {
+	now := time.Now()
+	if c.closing.Load() || !now.Before(c.deadline) {
+		return
+	}
+	c.mu.Lock()
+	c.pendingWord, c.pendingAt = word, now
+	c.mu.Unlock()
+	c.room.markSent(word, now)
+	c.send(game.SUBMITJSONTYPE, word)
+}
+
+func (c *Client) send(msgType string, payload any) {
+	bytes, _ := json.Marshal(game.GameMessage{Type: msgType, Payload: payload})
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if err := c.conn.WriteMessage(websocket.TextMessage, bytes); err != nil && !c.closing.Load() {
+		c.stats.Error(ErrWrite)
+	}
+}
+
+// Close 는 연결을 닫는다. 아직 결과를 받지 못한 단어는 응답 없음으로 센다.
+func (c *Client) Close() {
+	if c.conn == nil || c.closing.Swap(true) {
+		return
+	}
+	c.mu.Lock()
+	if c.pendingWord != "" {
+		c.stats.Error(ErrNoResponse)
+		c.pendingWord = ""
+	}
+	c.mu.Unlock()
+
+	c.writeMu.Lock()
+	_ = c.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	c.writeMu.Unlock()
+	_ = c.conn.Close()
+}
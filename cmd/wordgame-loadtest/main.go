@@ -0,0 +1,175 @@
+// wordgame-loadtest 는 로컬 서버에 가상 접속자를 여러 방에 나눠 넣고 사전으로 게임을 진행시키며,
+// 접속 성공률, 단어 응답 지연, 브로드캐스트 fan-out 시간, 오류 수를 보고한다.
+//
+// 한 IP 에서 많은 연결과 방을 만들므로 서버는 제한을 풀고 띄운다.
+//
+//	MAX_CONNECTIONS_PER_IP=0 ROOM_CREATE_RATE_LIMIT=1000 ROOM_CREATE_BURST=1000 API_RATE_LIMIT=1000 API_RATE_BURST=1000 go run .
+//	go run ./cmd/wordgame-loadtest -rooms 20 -clients 100 -duration 1m
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"wordgame/internal/logging"
+	"wordgame/internal/random"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	createRoomAttempts = 5
+	requestTimeout     = 5 * time.Second
+	drainTimeout       = 2 * time.Second // 끝난 뒤 이미 낸 단어의 응답을 기다리는 시간
+)
+
+type options struct {
+	addr     string
+	rooms    int
+	clients  int
+	duration time.Duration
+	think    time.Duration
+	ramp     time.Duration
+	mistakes int
+	words    string
+	seed     int64
+	json     bool
+}
+
+func main() {
+	var opts options
+	flag.StringVar(&opts.addr, "addr", "localhost:3000", "server host:port")
+	flag.IntVar(&opts.rooms, "rooms", 10, "number of rooms (M)")
+	flag.IntVar(&opts.clients, "clients", 40, "number of simulated clients (N), spread evenly over the rooms")
+	flag.DurationVar(&opts.duration, "duration", 30*time.Second, "how long to keep playing after everyone has joined")
+	flag.DurationVar(&opts.think, "think", 300*time.Millisecond, "delay before a client submits a word on its turn")
+	flag.DurationVar(&opts.ramp, "ramp", 5*time.Millisecond, "delay between connection attempts")
+	flag.IntVar(&opts.mistakes, "mistakes", 5, "percent of turns that submit a word outside the dictionary")
+	flag.StringVar(&opts.words, "words", "", "word file (one per line) instead of data/kr_korean.db")
+	flag.Int64Var(&opts.seed, "seed", 0, "random seed (0 picks one from the clock)")
+	flag.BoolVar(&opts.json, "json", false, "print the report as JSON")
+	flag.Parse()
+
+	logger := logging.NewFromEnv()
+	slog.SetDefault(logger)
+	if opts.rooms < 1 || opts.clients < 2*opts.rooms {
+		fmt.Fprintln(os.Stderr, "need at least one room and two clients per room")
+		os.Exit(2)
+	}
+
+	dict, err := loadDictionary(opts.words)
+	if err != nil {
+		logging.Error(logger, "dictionary_load_failed", logging.ErrorKey, err)
+		os.Exit(1)
+	}
+	randomManager := random.NewManager()
+	if opts.seed != 0 {
+		randomManager = random.NewSeededManager(opts.seed)
+	}
+	logging.Info(logger, "loadtest_started", "addr", opts.addr, "rooms", opts.rooms, "clients", opts.clients, "seed", randomManager.Seed())
+
+	report := run(opts, &WordPicker{dict: dict, random: randomManager, mistakePercent: opts.mistakes}, logger)
+	if opts.json {
+		_ = json.NewEncoder(os.Stdout).Encode(report)
+		return
+	}
+	report.WriteText(os.Stdout)
+}
+
+// run 은 방을 만들고 접속자를 넣은 뒤 duration 동안 게임을 진행시킨다.
+func run(opts options, picker *WordPicker, logger *slog.Logger) Report {
+	stats := NewStats()
+	begin := time.Now()
+
+	var rooms []*Room
+	for i := 0; i < opts.rooms; i++ {
+		id, err := createRoom(opts.addr, fmt.Sprintf("loadtest-%d", i+1))
+		if err != nil {
+			stats.Error(ErrCreateRoom)
+			logging.Warn(logger, "room_create_failed", logging.ErrorKey, err)
+			continue
+		}
+		rooms = append(rooms, NewRoom(id, stats))
+	}
+	if len(rooms) == 0 {
+		return stats.Report(0, time.Since(begin))
+	}
+
+	var clients []*Client
+	for i := 0; i < opts.clients; i++ {
+		room := rooms[i%len(rooms)]
+		c := &Client{
+			name:   fmt.Sprintf("bot%d", i+1),
+			room:   room,
+			stats:  stats,
+			picker: picker,
+			think:  opts.think,
+		}
+		if err := c.Connect(opts.addr, requestTimeout); err != nil {
+			logging.Debug(logger, "client_connect_failed", logging.RoomIDKey, room.ID, logging.ErrorKey, err)
+		} else {
+			clients = append(clients, c)
+		}
+		time.Sleep(opts.ramp)
+	}
+
+	// 접속이 모두 끝난 뒤부터 duration 을 잰다. 접속하는 동안 받은 상태는 Run 이 차례대로 읽으므로
+	// 접속자는 로비에서 준비하고, 방장은 모두 들어와 준비한 상태를 보고 게임을 시작한다.
+	deadline := time.Now().Add(opts.duration)
+	var wg sync.WaitGroup
+	for _, c := range clients {
+		c.deadline = deadline
+		wg.Add(1)
+		go func(c *Client) {
+			defer wg.Done()
+			c.Run()
+		}(c)
+	}
+
+	time.Sleep(time.Until(deadline) + drainTimeout)
+	for _, c := range clients {
+		c.Close()
+	}
+	wg.Wait()
+	return stats.Report(len(rooms), time.Since(begin))
+}
+
+// createRoom 은 기본 설정으로 방을 만든다. 요청 제한에 걸리면 잠시 쉬고 다시 시도한다.
+func createRoom(addr, name string) (int, error) {
+	body, _ := json.Marshal(fiber.Map{"roomName": name})
+	client := &http.Client{Timeout: requestTimeout}
+
+	var lastErr error
+	for attempt := 1; attempt <= createRoomAttempts; attempt++ {
+		resp, err := client.Post("http://"+addr+"/api/rooms", fiber.MIMEApplicationJSON, bytes.NewReader(body))
+		if err != nil {
+			return 0, err
+		}
+		var room struct {
+			ID    int    `json:"id"`
+			Error string `json:"error"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&room)
+		resp.Body.Close()
+
+		switch {
+		case resp.StatusCode == http.StatusTooManyRequests:
+			lastErr = fmt.Errorf("room creation rate limited")
+			time.Sleep(time.Duration(attempt) * time.Second)
+		case resp.StatusCode != http.StatusOK:
+			return 0, fmt.Errorf("status %d: %s", resp.StatusCode, room.Error)
+		case err != nil:
+			return 0, err
+		default:
+			return room.ID, nil
+		}
+	}
+	return 0, lastErr
+}
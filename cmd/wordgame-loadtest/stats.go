@@ -0,0 +1,208 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 오류 종류. 보고서에서 이 이름으로 센다.
+const (
+	ErrCreateRoom  = "create_room"
+	ErrDial        = "dial"
+	ErrRejected    = "rejected" // 서버가 입장을 거절했다 (방 가득 참, 연결 수 제한 등)
+	ErrWrite       = "write"
+	ErrRead        = "read"         // 측정 중에 연결이 끊겼다
+	ErrServer      = "server_error" // 게임 중에 받은 error 알림
+	ErrNoResponse  = "no_response"  // 낸 단어에 대한 상태를 끝까지 받지 못했다
+	ErrBadResponse = "bad_response" // 읽을 수 없는 메시지
+)
+
+// Stats 는 모든 클라이언트가 함께 쓰는 측정값이다.
+type Stats struct {
+	mu sync.Mutex
+
+	attempted int
+	connected int
+	connect   []time.Duration
+	latency   []time.Duration
+	fanout    []time.Duration
+
+	gamesStarted  int
+	gamesFinished int
+	wordsAccepted int
+	wordsRejected int
+	errors        map[string]int
+}
+
+func NewStats() *Stats {
+	return &Stats{errors: make(map[string]int)}
+}
+
+func (s *Stats) Attempt() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempted++
+}
+
+func (s *Stats) Connected(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.connected++
+	s.connect = append(s.connect, d)
+}
+
+// Latency 는 단어를 낸 뒤 그 결과 상태를 받기까지 걸린 시간이다.
+func (s *Stats) Latency(d time.Duration, accepted bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = append(s.latency, d)
+	if accepted {
+		s.wordsAccepted++
+	} else {
+		s.wordsRejected++
+	}
+}
+
+// Fanout 은 단어를 낸 뒤 방의 마지막 접속자가 그 상태를 받기까지 걸린 시간이다.
+func (s *Stats) Fanout(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fanout = append(s.fanout, d)
+}
+
+func (s *Stats) GameStarted() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gamesStarted++
+}
+
+func (s *Stats) GameFinished() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gamesFinished++
+}
+
+func (s *Stats) Error(kind string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errors[kind]++
+}
+
+// Summary 는 보고서에 쓰는 지연 시간 분포다.
+type Summary struct {
+	Count int           `json:"count"`
+	P50   time.Duration `json:"p50"`
+	P90   time.Duration `json:"p90"`
+	P99   time.Duration `json:"p99"`
+	Max   time.Duration `json:"max"`
+}
+
+func Summarize(samples []time.Duration) Summary {
+	if len(samples) == 0 {
+		return Summary{}
+	}
+	sorted := append([]time.Duration(nil), samples...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return Summary{
+		Count: len(sorted),
+		P50:   Percentile(sorted, 50),
+		P90:   Percentile(sorted, 90),
+		P99:   Percentile(sorted, 99),
+		Max:   sorted[len(sorted)-1],
+	}
+}
+
+// Percentile 은 정렬된 값에서 nearest-rank 방식으로 p 백분위 값을 고른다.
+func Percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return sorted[rank-1]
+}
+
+type Report struct {
+	Duration      time.Duration  `json:"duration"`
+	Rooms         int            `json:"rooms"`
+	Attempted     int            `json:"attempted"`
+	Connected     int            `json:"connected"`
+	Connect       Summary        `json:"connect"`
+	Latency       Summary        `json:"latency"`
+	Fanout        Summary        `json:"fanout"`
+	GamesStarted  int            `json:"gamesStarted"`
+	GamesFinished int            `json:"gamesFinished"`
+	WordsAccepted int            `json:"wordsAccepted"`
+	WordsRejected int            `json:"wordsRejected"`
+	Errors        map[string]int `json:"errors"`
+}
+
+func (s *Stats) Report(rooms int, elapsed time.Duration) Report {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	errors := make(map[string]int, len(s.errors))
+	for kind, count := range s.errors {
+		errors[kind] = count
+	}
+	return Report{
+		Duration:      elapsed,
+		Rooms:         rooms,
+		Attempted:     s.attempted,
+		Connected:     s.connected,
+		Connect:       Summarize(s.connect),
+		Latency:       Summarize(s.latency),
+		Fanout:        Summarize(s.fanout),
+		GamesStarted:  s.gamesStarted,
+		GamesFinished: s.gamesFinished,
+		WordsAccepted: s.wordsAccepted,
+		WordsRejected: s.wordsRejected,
+		Errors:        errors,
+	}
+}
+
+func (r Report) WriteText(w io.Writer) {
+	rate := 0.0
+	if r.Attempted > 0 {
+		rate = float64(r.Connected) / float64(r.Attempted) * 100
+	}
+	fmt.Fprintf(w, "duration     %s, %d rooms\n", r.Duration.Round(time.Millisecond), r.Rooms)
+	fmt.Fprintf(w, "connections  %d/%d succeeded (%.1f%%)\n", r.Connected, r.Attempted, rate)
+	fmt.Fprintf(w, "connect      %s\n", r.Connect)
+	fmt.Fprintf(w, "latency      %s\n", r.Latency)
+	fmt.Fprintf(w, "fan-out      %s\n", r.Fanout)
+	fmt.Fprintf(w, "games        %d started, %d finished\n", r.GamesStarted, r.GamesFinished)
+	fmt.Fprintf(w, "words        %d accepted, %d rejected\n", r.WordsAccepted, r.WordsRejected)
+
+	kinds := make([]string, 0, len(r.Errors))
+	for kind := range r.Errors {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	parts := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		parts = append(parts, fmt.Sprintf("%s=%d", kind, r.Errors[kind]))
+	}
+	if len(parts) == 0 {
+		parts = append(parts, "none")
+	}
+	fmt.Fprintf(w, "errors       %s\n", strings.Join(parts, " "))
+}
+
+func (s Summary) String() string {
+	if s.Count == 0 {
+		return "no samples"
+	}
+	return fmt.Sprintf("n=%d p50=%s p90=%s p99=%s max=%s", s.Count,
+		s.P50.Round(time.Microsecond), s.P90.Round(time.Microsecond),
+		s.P99.Round(time.Microsecond), s.Max.Round(time.Microsecond))
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPercentile(t *testing.T) {
+	sorted := make([]time.Duration, 100)
+	for i := range sorted {
+		sorted[i] = time.Duration(i+1) * time.Millisecond
+	}
+
+	assert.Equal(t, 50*time.Millisecond, Percentile(sorted, 50))
+	assert.Equal(t, 90*time.Millisecond, Percentile(sorted, 90))
+	assert.Equal(t, 99*time.Millisecond, Percentile(sorted, 99))
+	assert.Equal(t, time.Millisecond, Percentile(sorted, 0), "Lowest rank should be the first sample")
+	assert.Equal(t, time.Duration(0), Percentile(nil, 50))
+}
+
+func TestSummarizeDoesNotReorderSamples(t *testing.T) {
+	samples := []time.Duration{3 * time.Millisecond, time.Millisecond, 2 * time.Millisecond}
+
+	summary := Summarize(samples)
+
+	assert.Equal(t, 3, summary.Count)
+	assert.Equal(t, 2*time.Millisecond, summary.P50)
+	assert.Equal(t, 3*time.Millisecond, summary.Max)
+	assert.Equal(t, 3*time.Millisecond, samples[0], "Samples should be copied before sorting")
+}
+
+func TestRoomFanoutWaitsForEveryMember(t *testing.T) {
+	stats := NewStats()
+	room := NewRoom(1, stats)
+	room.join()
+	room.join()
+	sent := time.Unix(0, 0)
+
+	room.markSent("사과", sent)
+	room.markReceived("사과", sent.Add(time.Millisecond))
+	assert.Empty(t, stats.Report(1, 0).Fanout.Count, "Fan-out should not be recorded until everyone has received it")
+
+	room.markReceived("사과", sent.Add(3*time.Millisecond))
+	report := stats.Report(1, 0)
+	assert.Equal(t, 1, report.Fanout.Count)
+	assert.Equal(t, 3*time.Millisecond, report.Fanout.Max)
+}
+
+func TestReportText(t *testing.T) {
+	stats := NewStats()
+	stats.Attempt()
+	stats.Attempt()
+	stats.Connected(time.Millisecond)
+	stats.Error(ErrRejected)
+
+	var buf bytes.Buffer
+	stats.Report(1, time.Second).WriteText(&buf)
+
+	assert.Contains(t, buf.String(), "1/2 succeeded (50.0%)")
+	assert.Contains(t, buf.String(), "rejected=1")
+	assert.Contains(t, buf.String(), "latency      no samples")
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"wordgame/internal/game"
+	"wordgame/internal/random"
+	"wordgame/internal/store"
+)
+
+// dictionary 는 단어를 고를 때 쓰는 사전 기능이다. store.Dictionary 와 store.MemoryDictionary 가 구현한다.
+type dictionary interface {
+	WordsByKey(name string, key store.KeyFunc, value string) ([]string, bool)
+	CountWordsByKey(name string, key store.KeyFunc, value string) (int, bool)
+}
+
+// loadDictionary 는 단어 파일(한 줄에 한 단어)이 있으면 그것을, 없으면 서버와 같은 사전 DB 를 읽는다.
+func loadDictionary(path string) (dictionary, error) {
+	if path == "" {
+		db, err := store.NewDBManager()
+		if err != nil {
+			return nil, err
+		}
+		return db.Dictionary(store.DefaultLanguage), nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var words []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if word := strings.TrimSpace(scanner.Text()); word != "" {
+			words = append(words, word)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return store.NewMemoryDictionary(store.DefaultLanguage, words), nil
+}
+
+// WordPicker 는 끝말잇기 규칙(기본 방 설정)으로 다음 단어를 고른다.
+type WordPicker struct {
+	dict           dictionary
+	random         *random.Manager
+	mistakePercent int
+}
+
+func firstSyllable(word string) string {
+	r, _ := utf8.DecodeRuneInString(word)
+	return string(r)
+}
+
+func lastSyllable(word string) string {
+	r, _ := utf8.DecodeLastRuneInString(word)
+	return string(r)
+}
+
+// Next 는 last 에 이어지는 쓰지 않은 단어를 고른다. 게임이 오래 가도록 한방 단어는 마지막에 고른다.
+// mistakePercent 확률로, 또는 이어지는 단어가 없으면 사전에 없는 단어를 내서 거절 경로도 부하에 넣는다.
+func (p *WordPicker) Next(last string, used map[string]bool) string {
+	tail := lastSyllable(last)
+	mistake := tail + "뷁뷁"
+	if p.random.MakeRandomNumber(0, 100) < p.mistakePercent {
+		return mistake
+	}
+
+	candidates, _ := p.dict.WordsByKey(game.ChainRuleLastSyllable, firstSyllable, tail)
+	if len(candidates) == 0 {
+		return mistake
+	}
+	fallback := ""
+	offset := p.random.MakeRandomNumber(0, len(candidates))
+	for i := range candidates {
+		word := candidates[(offset+i)%len(candidates)]
+		if used[word] || utf8.RuneCountInString(word) < 2 {
+			continue
+		}
+		if next, _ := p.dict.CountWordsByKey(game.ChainRuleLastSyllable, firstSyllable, lastSyllable(word)); next > 0 {
+			return word
+		}
+		if fallback == "" {
+			fallback = word
+		}
+	}
+	if fallback == "" {
+		return mistake
+	}
+	return fallback
+}
@@ -0,0 +1,120 @@
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock 은 지금 시각과 타이머를 돌려준다. 서버는 Real 을, 테스트는 Fake 를 쓴다.
+type Clock interface {
+	Now() time.Time
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// Timer 는 AfterFunc 로 건 타이머다. Stop 은 아직 실행되지 않은 타이머를 멈추면 true 를 돌려준다.
+type Timer interface {
+	Stop() bool
+}
+
+type Real struct{}
+
+func (Real) Now() time.Time {
+	return time.Now()
+}
+
+func (Real) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
+
+// Fake 는 Advance 를 부를 때만 시간이 흐르는 시계다.
+// 때가 된 타이머는 Advance 를 부른 고루틴에서 시각 순서대로 실행된다.
+type Fake struct {
+	mu     sync.Mutex
+	now    time.Time
+	seq    int
+	timers []*fakeTimer
+}
+
+type fakeTimer struct {
+	clock *Fake
+	at    time.Time
+	seq   int
+	f     func()
+}
+
+func NewFake(start time.Time) *Fake {
+	return &Fake{now: start}
+}
+
+func (c *Fake) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *Fake) AfterFunc(d time.Duration, f func()) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.seq++
+	t := &fakeTimer{clock: c, at: c.now.Add(d), seq: c.seq, f: f}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+// Advance 는 시간을 d 만큼 흘리고 그 사이에 때가 된 타이머를 실행한다.
+// 타이머가 새 타이머를 걸어도 d 안에 때가 되면 함께 실행된다.
+func (c *Fake) Advance(d time.Duration) {
+	c.mu.Lock()
+	end := c.now.Add(d)
+	c.mu.Unlock()
+
+	for {
+		c.mu.Lock()
+		t := c.nextDue(end)
+		if t == nil {
+			c.now = end
+			c.mu.Unlock()
+			return
+		}
+		c.now = t.at
+		c.mu.Unlock()
+		t.f()
+	}
+}
+
+// Pending 은 아직 실행되지 않은 타이머 수다.
+func (c *Fake) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.timers)
+}
+
+// nextDue 는 end 까지 때가 된 가장 이른 타이머를 목록에서 빼서 돌려준다. (잠금은 호출자가 관리)
+func (c *Fake) nextDue(end time.Time) *fakeTimer {
+	sort.Slice(c.timers, func(i, j int) bool {
+		if c.timers[i].at.Equal(c.timers[j].at) {
+			return c.timers[i].seq < c.timers[j].seq
+		}
+		return c.timers[i].at.Before(c.timers[j].at)
+	})
+	if len(c.timers) == 0 || c.timers[0].at.After(end) {
+		return nil
+	}
+	t := c.timers[0]
+	c.timers = c.timers[1:]
+	return t
+}
+
+func (t *fakeTimer) Stop() bool {
+	c := t.clock
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, pending := range c.timers {
+		if pending == t {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
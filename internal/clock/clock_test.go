@@ -0,0 +1,31 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeAdvance(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewFake(start)
+	var fired []string
+
+	c.AfterFunc(2*time.Second, func() { fired = append(fired, "second") })
+	c.AfterFunc(time.Second, func() {
+		fired = append(fired, "first")
+		c.AfterFunc(time.Second, func() { fired = append(fired, "chained") })
+	})
+	stopped := c.AfterFunc(time.Second, func() { fired = append(fired, "stopped") })
+	assert.True(t, stopped.Stop(), "Pending timer should be stoppable")
+
+	c.Advance(500 * time.Millisecond)
+	assert.Empty(t, fired, "No timer should fire before its time")
+
+	c.Advance(2 * time.Second)
+	assert.Equal(t, []string{"first", "second", "chained"}, fired, "Timers should fire in time order")
+	assert.Equal(t, start.Add(2500*time.Millisecond), c.Now())
+	assert.Zero(t, c.Pending())
+	assert.False(t, stopped.Stop(), "Stopping twice should report nothing was stopped")
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInt(t *testing.T) {
+	t.Setenv("TEST_INT", "42")
+	t.Setenv("TEST_INT_INVALID", "abc")
+
+	assert.Equal(t, 42, Int("TEST_INT", 1))
+	assert.Equal(t, 1, Int("TEST_INT_INVALID", 1), "Invalid value should fall back to the default")
+	assert.Equal(t, 1, Int("TEST_INT_MISSING", 1), "Missing value should fall back to the default")
+}
+
+func TestFloat(t *testing.T) {
+	t.Setenv("TEST_FLOAT", "0.5")
+	t.Setenv("TEST_FLOAT_INVALID", "abc")
+
+	assert.Equal(t, 0.5, Float("TEST_FLOAT", 1))
+	assert.Equal(t, 1.0, Float("TEST_FLOAT_INVALID", 1), "Invalid value should fall back to the default")
+}
+
+func TestBool(t *testing.T) {
+	t.Setenv("TEST_BOOL", "true")
+
+	assert.True(t, Bool("TEST_BOOL", false))
+	assert.False(t, Bool("TEST_BOOL_MISSING", false))
+}
+
+func TestDuration(t *testing.T) {
+	t.Setenv("TEST_DURATION", "90s")
+
+	assert.Equal(t, 90*time.Second, Duration("TEST_DURATION", time.Second))
+	assert.Equal(t, time.Second, Duration("TEST_DURATION_MISSING", time.Second))
+}
+
+func TestString(t *testing.T) {
+	t.Setenv("TEST_STRING", " json ")
+
+	assert.Equal(t, "json", String("TEST_STRING", "text"))
+	assert.Equal(t, "text", String("TEST_STRING_MISSING", "text"))
+}
+
+func TestRateLimits(t *testing.T) {
+	t.Setenv("API_RATE_BURST", "5")
+
+	api := APIRateLimit()
+	assert.Equal(t, DefaultAPIRateLimit, api.Rate)
+	assert.Equal(t, 5, api.Burst, "Environment should override the default")
+	assert.Equal(t, DefaultAPIBanDuration, api.BanFor)
+	assert.Equal(t, DefaultRoomCreateBurst, RoomCreateRateLimit().Burst)
+}
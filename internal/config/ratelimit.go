@@ -0,0 +1,35 @@
+package config
+
+import (
+	"time"
+
+	"wordgame/internal/ratelimit"
+)
+
+// API 요청 속도 제한의 기본값이다. 환경변수로 바꿀 수 있다.
+const (
+	DefaultAPIRateLimit        = 10.0
+	DefaultAPIRateBurst        = 20
+	DefaultAPIBanAfter         = 50
+	DefaultAPIBanDuration      = 5 * time.Minute
+	DefaultRoomCreateRateLimit = 0.2
+	DefaultRoomCreateBurst     = 3
+)
+
+// APIRateLimit 는 모든 API 요청에 거는 속도 제한이다.
+func APIRateLimit() ratelimit.Config {
+	return ratelimit.Config{
+		Rate:     Float("API_RATE_LIMIT", DefaultAPIRateLimit),
+		Burst:    Int("API_RATE_BURST", DefaultAPIRateBurst),
+		BanAfter: Int("API_BAN_AFTER", DefaultAPIBanAfter),
+		BanFor:   Duration("API_BAN_DURATION", DefaultAPIBanDuration),
+	}
+}
+
+// RoomCreateRateLimit 는 방 생성 요청에 따로 거는 속도 제한이다.
+func RoomCreateRateLimit() ratelimit.Config {
+	return ratelimit.Config{
+		Rate:  Float("ROOM_CREATE_RATE_LIMIT", DefaultRoomCreateRateLimit),
+		Burst: Int("ROOM_CREATE_BURST", DefaultRoomCreateBurst),
+	}
+}
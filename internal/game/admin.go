@@ -0,0 +1,97 @@
+package game
+
+import (
+	"errors"
+
+	"wordgame/internal/i18n"
+	"wordgame/internal/logging"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrGameNotStarted = errors.New("game is not started")
+	ErrUserNotFound   = errors.New("user not found")
+)
+
+// AdminState 는 관리자 API에서 보여줄 방의 전체 상태를 만든다.
+func (g *Game) AdminState() fiber.Map {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	state := g.makeSendForm(i18n.DefaultLocale, g.makePlayerList(), g.makeSpectatorList(), g.makeHintInfo())
+	state["id"] = g.RoomId
+	state["roomName"] = g.RoomName
+	state["usedWordCount"] = len(g.usedWords)
+	return state
+}
+
+func (g *Game) ForceEnd() error {
+	g.mu.Lock()
+	started := g.inGame()
+	g.mu.Unlock()
+
+	if !started {
+		return ErrGameNotStarted
+	}
+	logging.Info(g.logger, FORCEENDLOGMSG)
+	g.endGame(i18n.New(ADMINENDMSG))
+	return nil
+}
+
+// KickUser 는 공개 ID(태그)로 가리킨 접속자를 내보낸다. 관리자 상태에도 공개 ID 만 보인다.
+func (g *Game) KickUser(tag string) error {
+	g.mu.Lock()
+	user := g.findUserByTag(tag)
+	g.mu.Unlock()
+
+	if user == nil {
+		return ErrUserNotFound
+	}
+	logging.Info(g.logger, KICKLOGMSG, logging.UserIDKey, user.ID, logging.UserNameKey, user.Name)
+	if user.IsBot() {
+		// 봇은 연결이 없으므로 바로 방에서 뺀다.
+		g.removeUser(user)
+		g.broadcastGameState()
+		return nil
+	}
+	g.sendNotice(user, KICKEDCODE, i18n.New(KICKEDMSG))
+	// 연결을 닫으면 ReadLoop가 끝나면서 handleClientDisconnect가 나머지를 정리한다.
+	user.Close()
+	return nil
+}
+
+func (g *Game) Announce(message string) {
+	g.broadcastNotice(ANNOUNCEMENTCODE, i18n.New(ANNOUNCEMENTMSG, "text", message))
+}
+
+// Close 는 남아있는 모든 접속자에게 알리고 연결을 끊는다.
+func (g *Game) Close(message i18n.Message) {
+	g.mu.Lock()
+	users := make([]*User, 0, len(g.players)+len(g.spectators))
+	users = append(users, g.players...)
+	users = append(users, g.spectators...)
+	g.mu.Unlock()
+
+	// 브로드캐스트는 Room 고루틴에서 비동기로 쓰이므로, 닫기 전에 직접 알린다.
+	for _, user := range users {
+		g.sendNotice(user, ROOMCLOSEDCODE, message)
+		user.Close()
+	}
+	g.room.Stop()
+	logging.Info(g.logger, CLOSEROOMLOGMSG)
+}
+
+func (g *Game) findUser(userID string) *User {
+	for _, p := range g.players {
+		if p.ID == userID {
+			return p
+		}
+	}
+	for _, s := range g.spectators {
+		if s.ID == userID {
+			return s
+		}
+	}
+	return nil
+}
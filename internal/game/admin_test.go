@@ -0,0 +1,33 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminState(t *testing.T) {
+	g := SetupTestGame()
+	g.players = []*User{{ID: "1001", Name: "Alice", Tag: "7001"}, {ID: "1002", Name: "Bob", Tag: "7002"}}
+	g.hostUserId = "1001"
+	g.usedWords["사과"] = true
+
+	state := g.AdminState()
+
+	assert.Equal(t, g.RoomId, state["id"], "State should contain the room ID")
+	assert.Equal(t, 1, state["usedWordCount"], "State should contain the used word count")
+	assert.Equal(t, "7001", state["hostUserId"], "State should contain the host's public ID")
+	assert.Len(t, state["players"], 2, "State should contain the players")
+}
+
+func TestForceEndNotStarted(t *testing.T) {
+	g := SetupTestGame()
+
+	assert.ErrorIs(t, g.ForceEnd(), ErrGameNotStarted, "A game in the lobby cannot be force-ended")
+}
+
+func TestKickUnknownUser(t *testing.T) {
+	g := SetupTestGame()
+
+	assert.ErrorIs(t, g.KickUser("9999"), ErrUserNotFound, "Kicking an unknown user should fail")
+}
@@ -0,0 +1,269 @@
+package game
+
+import (
+	"errors"
+	"time"
+
+	"wordgame/internal/i18n"
+	"wordgame/internal/logging"
+)
+
+var (
+	ErrInvalidBotDifficulty = errors.New("invalid bot difficulty")
+	ErrBotNotFound          = errors.New("bot not found")
+)
+
+const (
+	BotDifficultyEasy   = "easy"
+	BotDifficultyNormal = "normal"
+	BotDifficultyHard   = "hard"
+)
+
+// botProfile 은 난이도별 봇의 행동이다.
+// failPercent 는 일부러 틀린 단어를 낼 확률이고, pick 은 이어지는 단어 중 하나를 고른다.
+type botProfile struct {
+	minDelay    time.Duration
+	maxDelay    time.Duration
+	failPercent int
+	pick        func(g *Game, candidates []string) string
+}
+
+var botProfiles = map[string]botProfile{
+	// 쉬운 봇은 느리고 가끔 틀리며, 다음 단어가 많은 흔한 단어를 고른다.
+	BotDifficultyEasy:   {minDelay: 4 * time.Second, maxDelay: 8 * time.Second, failPercent: 20, pick: pickCommonWord},
+	BotDifficultyNormal: {minDelay: 2 * time.Second, maxDelay: 5 * time.Second, failPercent: 5, pick: pickRandomWord},
+	// 어려운 봇은 빠르고 틀리지 않으며, 한방 단어나 다음 단어가 적은 단어를 고른다.
+	BotDifficultyHard: {minDelay: 500 * time.Millisecond, maxDelay: 1500 * time.Millisecond, failPercent: 0, pick: pickRareWord},
+}
+
+type addBotPayload struct {
+	Difficulty string `json:"difficulty"`
+}
+
+type removeBotPayload struct {
+	UserID string `json:"userId"` // 공개 ID(태그)
+}
+
+func IsValidBotDifficulty(difficulty string) bool {
+	_, ok := botProfiles[difficulty]
+	return ok
+}
+
+// AddBot 은 방장이 로비에서 빈 플레이어 자리에 봇을 넣는다.
+func (g *Game) AddBot(hostID, difficulty string) (*User, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err := g.checkBotEditable(hostID); err != nil {
+		return nil, err
+	}
+	if !IsValidBotDifficulty(difficulty) {
+		return nil, ErrInvalidBotDifficulty
+	}
+	if g.isPlayerSlotFull() {
+		return nil, ErrRoomFull
+	}
+	id, err := generateUserID()
+	if err != nil {
+		return nil, err
+	}
+	bot := NewBot(id, difficulty, g.logger)
+	if err := g.assignTag(bot); err != nil {
+		return nil, err
+	}
+	g.players = append(g.players, bot)
+	g.assignTeamOnJoin(bot)
+	logging.Info(g.logger, BOTADDEDLOGMSG, logging.UserIDKey, bot.ID, "difficulty", difficulty, "tag", bot.Tag)
+	return bot, nil
+}
+
+// RemoveBot 은 방장이 로비에서 봇을 뺀다. 봇은 공개 ID(태그)로 가리킨다.
+func (g *Game) RemoveBot(hostID, tag string) error {
+	g.mu.Lock()
+	if err := g.checkBotEditable(hostID); err != nil {
+		g.mu.Unlock()
+		return err
+	}
+	bot := g.findUserByTag(tag)
+	g.mu.Unlock()
+
+	if bot == nil || !bot.IsBot() {
+		return ErrBotNotFound
+	}
+	logging.Info(g.logger, BOTREMOVEDLOGMSG, logging.UserIDKey, bot.ID)
+	g.removeUser(bot)
+	return nil
+}
+
+func (g *Game) checkBotEditable(hostID string) error {
+	if g.hostUserId != hostID {
+		return ErrNotHost
+	}
+	if g.isStarted() {
+		return ErrGameInProgress
+	}
+	return nil
+}
+
+func (g *Game) handleAddBot(user *User, gameMessage GameMessage) {
+	var payload addBotPayload
+	if err := decodePayload(gameMessage.Payload, &payload); err != nil {
+		logging.Warn(g.logger, BOTPAYLOADERROR, logging.UserIDKey, user.ID, "payload", gameMessage.Payload)
+		return
+	}
+	if _, err := g.AddBot(user.ID, payload.Difficulty); err != nil {
+		g.sendNotice(user, BOTERRORCODE, i18n.New(botErrorMessage(err)))
+		return
+	}
+	g.broadcastGameState()
+}
+
+func (g *Game) handleRemoveBot(user *User, gameMessage GameMessage) {
+	var payload removeBotPayload
+	if err := decodePayload(gameMessage.Payload, &payload); err != nil {
+		logging.Warn(g.logger, BOTPAYLOADERROR, logging.UserIDKey, user.ID, "payload", gameMessage.Payload)
+		return
+	}
+	if err := g.RemoveBot(user.ID, payload.UserID); err != nil {
+		g.sendNotice(user, BOTERRORCODE, i18n.New(botErrorMessage(err)))
+		return
+	}
+	g.broadcastGameState()
+}
+
+func botErrorMessage(err error) string {
+	switch {
+	case errors.Is(err, ErrNotHost):
+		return NOHOSTBOTMSG
+	case errors.Is(err, ErrGameInProgress):
+		return GAMEALREADYSTARTEDMSG
+	case errors.Is(err, ErrRoomFull):
+		return ROOMFULLMSG
+	case errors.Is(err, ErrInvalidBotDifficulty):
+		return INVALIDBOTDIFFICULTYMSG
+	default:
+		return BOTNOTFOUNDMSG
+	}
+}
+
+// 아래 함수들은 playBotTurn 을 빼고 모두 잠금을 호출자가 관리한다.
+
+// scheduleBotTurn 은 지금 차례가 봇이면 난이도에 맞게 기다렸다가 단어를 내도록 예약한다.
+func (g *Game) scheduleBotTurn() {
+	if !g.isTurnOpen() || g.botTimer != nil {
+		return
+	}
+	bot := g.findUser(g.currentUserID)
+	if bot == nil || !bot.IsBot() {
+		return
+	}
+	profile := botProfiles[bot.BotDifficulty]
+	delay := profile.minDelay
+	if spread := int((profile.maxDelay - profile.minDelay) / time.Millisecond); spread > 0 {
+		delay += time.Duration(g.random.MakeRandomNumber(0, spread)) * time.Millisecond
+	}
+
+	g.botTurn++
+	turn := g.botTurn
+	g.botTimer = g.clock.AfterFunc(delay, func() {
+		g.playBotTurn(bot, turn)
+	})
+}
+
+// playBotTurn 은 타이머 고루틴에서 불린다. 봇의 단어도 사람과 같이 handlePlay 로 검사한다.
+func (g *Game) playBotTurn(bot *User, turn int) {
+	g.mu.Lock()
+	if turn != g.botTurn {
+		g.mu.Unlock()
+		return
+	}
+	g.botTimer = nil
+	if !g.isTurnOpen() || g.currentUserID != bot.ID {
+		g.mu.Unlock()
+		return
+	}
+	word := g.chooseBotWord(bot)
+	g.mu.Unlock()
+
+	logging.Debug(g.logger, BOTPLAYLOGMSG, logging.UserIDKey, bot.ID, "word", word)
+	g.handlePlay(bot, word)
+}
+
+func (g *Game) stopBotTimer() {
+	if g.botTimer != nil {
+		g.botTimer.Stop()
+		g.botTimer = nil
+	}
+	g.botTurn++
+}
+
+// chooseBotWord 는 봇이 낼 단어를 고른다. 이을 단어가 없거나 일부러 틀릴 때는 앞 단어를 다시 내서 거절당한다.
+func (g *Game) chooseBotWord(bot *User) string {
+	profile := botProfiles[bot.BotDifficulty]
+	candidates := g.nextWordCandidates()
+	if len(candidates) == 0 || g.random.MakeRandomNumber(0, 100) < profile.failPercent {
+		return g.lastWord
+	}
+	return profile.pick(g, candidates)
+}
+
+func pickRandomWord(g *Game, candidates []string) string {
+	return candidates[g.random.MakeRandomNumber(0, len(candidates))]
+}
+
+func pickCommonWord(g *Game, candidates []string) string {
+	best, bestCount := candidates[0], -1
+	for _, w := range candidates {
+		if count, _ := g.continuationCount(w); count > bestCount {
+			best, bestCount = w, count
+		}
+	}
+	return best
+}
+
+func pickRareWord(g *Game, candidates []string) string {
+	best, bestCount := candidates[0], -1
+	for _, w := range candidates {
+		count, _ := g.continuationCount(w)
+		if bestCount < 0 || count < bestCount {
+			best, bestCount = w, count
+		}
+	}
+	return best
+}
+
+// hasHumanPlayer 는 봇이 아닌 플레이어가 남아 있는지 확인한다.
+func (g *Game) hasHumanPlayer() bool {
+	for _, p := range g.players {
+		if !p.IsBot() {
+			return true
+		}
+	}
+	return false
+}
+
+// removeBots 는 사람이 모두 나간 방에서 봇을 치운다.
+func (g *Game) removeBots() {
+	g.stopBotTimer()
+	// append 로 합치면 players 의 남는 용량에 관전자를 덮어쓸 수 있으므로 따로 돈다.
+	for _, users := range [][]*User{g.players, g.spectators} {
+		for _, u := range users {
+			if u.IsBot() {
+				delete(g.teams, u.ID)
+				delete(g.lives, u.ID)
+			}
+		}
+	}
+	g.players = withoutBots(g.players)
+	g.spectators = withoutBots(g.spectators)
+}
+
+func withoutBots(users []*User) []*User {
+	humans := make([]*User, 0, len(users))
+	for _, u := range users {
+		if !u.IsBot() {
+			humans = append(humans, u)
+		}
+	}
+	return humans
+}
@@ -0,0 +1,93 @@
+package game
+
+import (
+	"testing"
+
+	"wordgame/internal/store"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddBot(t *testing.T) {
+	g := SetupDefaultPlayers(WithPlayers(1))
+
+	bot, err := g.AddBot("1001", BotDifficultyHard)
+
+	assert.NoError(t, err)
+	assert.True(t, bot.IsBot())
+	assert.Len(t, g.players, 2, "Bot should take a player slot")
+	assert.NotEmpty(t, bot.Tag, "Bot should get a display tag")
+	assert.Equal(t, BotDifficultyHard, g.makePlayerInfo(bot).Bot, "State should show the bot difficulty")
+
+	_, err = g.AddBot("1002", BotDifficultyEasy)
+	assert.ErrorIs(t, err, ErrNotHost, "Only the host can add bots")
+	_, err = g.AddBot("1001", "impossible")
+	assert.ErrorIs(t, err, ErrInvalidBotDifficulty)
+}
+
+func TestRemoveBot(t *testing.T) {
+	g := SetupDefaultPlayers(WithPlayers(1))
+	bot, _ := g.AddBot("1001", BotDifficultyNormal)
+
+	assert.ErrorIs(t, g.RemoveBot("1001", "7001"), ErrBotNotFound, "Humans cannot be removed as bots")
+	assert.NoError(t, g.RemoveBot("1001", bot.Tag))
+	assert.Len(t, g.players, 1)
+}
+
+func TestBotsLeaveWithLastHuman(t *testing.T) {
+	g := SetupDefaultPlayers(WithPlayers(1))
+	g.AddBot("1001", BotDifficultyNormal)
+
+	g.removeUser(g.players[0])
+
+	assert.Empty(t, g.players, "Bots should not keep a room alive")
+	assert.Empty(t, g.hostUserId, "Bots should never become the host")
+}
+
+func TestRemoveBotsKeepsRosterBacking(t *testing.T) {
+	g := SetupDefaultPlayers(WithPlayers(1))
+	bot, _ := g.AddBot("1001", BotDifficultyNormal)
+	spectator := &User{ID: "2001", Name: "Dave", Tag: "8001"}
+	g.spectators = []*User{spectator}
+	g.lives[bot.ID] = 1
+	players := make([]*User, len(g.players), len(g.players)+1)
+	copy(players, g.players)
+	g.players = players
+
+	g.removeBots()
+
+	assert.Nil(t, players[:cap(players)][len(players)], "Spectators should not be written into the players' spare capacity")
+	assert.NotContains(t, g.lives, bot.ID)
+	assert.Equal(t, []*User{spectator}, g.spectators)
+}
+
+func TestChooseBotWord(t *testing.T) {
+	g := SetupDefaultPlayers(WithPlayers(1), WithWordList(store.WordListModeAllow, "사과", "과일", "과자", "일기"))
+	bot, _ := g.AddBot("1001", BotDifficultyHard)
+	g.lastWord = "사과"
+	g.usedWords = map[string]bool{"사과": true, "과자": true}
+
+	assert.Equal(t, []string{"과일"}, g.nextWordCandidates(), "Used words should not be candidates")
+	assert.Equal(t, "과일", g.chooseBotWord(bot))
+
+	g.usedWords["과일"] = true
+	assert.Equal(t, "사과", g.chooseBotWord(bot), "Bot without a candidate should submit a rejected word")
+}
+
+func TestBotPlaysThroughHandlePlay(t *testing.T) {
+	// 단어마다 이어지는 단어가 하나뿐이라 누가 먼저 하든 봇이 낼 단어가 정해진다.
+	next := map[string]string{"사과": "과자", "과자": "자사", "자사": "사과"}
+	g := SetupDefaultPlayers(WithPlayers(1), WithWordList(store.WordListModeAllow, "사과", "과자", "자사"))
+	host := g.players[0]
+	bot, _ := g.AddBot(host.ID, BotDifficultyHard)
+	startReadyGame(g, host)
+	if currentPlayer(g) == host {
+		playTurns(t, g, next[g.lastWord])
+	}
+	last := g.lastWord
+
+	FakeClock(g).Advance(botProfiles[BotDifficultyHard].maxDelay)
+
+	assert.Equal(t, next[last], g.lastWord, "Bot word should be accepted")
+	assert.NotEqual(t, bot.ID, g.currentUserID, "Turn should pass back to the human")
+}
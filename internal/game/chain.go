@@ -0,0 +1,80 @@
+package game
+
+// ChainRule 은 앞 단어와 다음 단어를 잇는 규칙이다.
+// Tail 은 앞 단어에서 다음 단어가 이어 받아야 할 부분이고, Head 는 다음 단어에서 그와 비교할 부분이다.
+type ChainRule interface {
+	Name() string
+	Tail(prev string) string
+	Head(next string) string
+	MismatchMessage() string
+}
+
+const (
+	ChainRuleLastSyllable     = "last_syllable"      // 끝말잇기
+	ChainRuleFirstSyllable    = "first_syllable"     // 앞말잇기
+	ChainRuleLastTwoSyllables = "last_two_syllables" // 끝 두 음절 잇기
+)
+
+var chainRules = map[string]ChainRule{
+	ChainRuleLastSyllable:     lastSyllableRule{},
+	ChainRuleFirstSyllable:    firstSyllableRule{},
+	ChainRuleLastTwoSyllables: lastTwoSyllablesRule{},
+}
+
+func IsValidChainRule(name string) bool {
+	_, ok := chainRules[name]
+	return ok
+}
+
+func chainRuleByName(name string) ChainRule {
+	if rule, ok := chainRules[name]; ok {
+		return rule
+	}
+	return chainRules[DefaultChainRule]
+}
+
+// isChainMatched 는 다음 단어가 규칙대로 앞 단어에 이어지는지 확인한다.
+func isChainMatched(rule ChainRule, prev, next string) bool {
+	tail := rule.Tail(prev)
+	return tail != "" && tail == rule.Head(next)
+}
+
+// 끝말잇기: 앞 단어의 끝 음절로 시작한다.
+type lastSyllableRule struct{}
+
+func (lastSyllableRule) Name() string            { return ChainRuleLastSyllable }
+func (lastSyllableRule) Tail(prev string) string { return lastRunes(prev, 1) }
+func (lastSyllableRule) Head(next string) string { return firstRunes(next, 1) }
+func (lastSyllableRule) MismatchMessage() string { return WORDMISMATCHMSG }
+
+// 앞말잇기: 앞 단어의 첫 음절로 끝난다.
+type firstSyllableRule struct{}
+
+func (firstSyllableRule) Name() string            { return ChainRuleFirstSyllable }
+func (firstSyllableRule) Tail(prev string) string { return firstRunes(prev, 1) }
+func (firstSyllableRule) Head(next string) string { return lastRunes(next, 1) }
+func (firstSyllableRule) MismatchMessage() string { return FIRSTSYLLABLEMISMATCHMSG }
+
+// 끝 두 음절 잇기: 앞 단어의 끝 두 음절로 시작한다.
+type lastTwoSyllablesRule struct{}
+
+func (lastTwoSyllablesRule) Name() string            { return ChainRuleLastTwoSyllables }
+func (lastTwoSyllablesRule) Tail(prev string) string { return lastRunes(prev, 2) }
+func (lastTwoSyllablesRule) Head(next string) string { return firstRunes(next, 2) }
+func (lastTwoSyllablesRule) MismatchMessage() string { return LASTTWOMISMATCHMSG }
+
+func firstRunes(word string, n int) string {
+	runes := []rune(word)
+	if len(runes) < n {
+		return ""
+	}
+	return string(runes[:n])
+}
+
+func lastRunes(word string, n int) string {
+	runes := []rune(word)
+	if len(runes) < n {
+		return ""
+	}
+	return string(runes[len(runes)-n:])
+}
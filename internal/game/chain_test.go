@@ -0,0 +1,43 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChainRules(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rule     string
+		prev     string
+		next     string
+		expected bool
+	}{
+		{name: "끝말잇기", rule: ChainRuleLastSyllable, prev: "사과", next: "과일", expected: true},
+		{name: "끝말잇기 불일치", rule: ChainRuleLastSyllable, prev: "사과", next: "일기", expected: false},
+		{name: "앞말잇기", rule: ChainRuleFirstSyllable, prev: "사과", next: "기사", expected: true},
+		{name: "앞말잇기 불일치", rule: ChainRuleFirstSyllable, prev: "사과", next: "사기", expected: false},
+		{name: "끝 두 음절 잇기", rule: ChainRuleLastTwoSyllables, prev: "고사리", next: "사리사욕", expected: true},
+		{name: "끝 두 음절 잇기 불일치", rule: ChainRuleLastTwoSyllables, prev: "고사리", next: "리본", expected: false},
+		{name: "한 글자 단어", rule: ChainRuleLastTwoSyllables, prev: "꽃", next: "꽃밭", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isChainMatched(chainRuleByName(tc.rule), tc.prev, tc.next))
+		})
+	}
+}
+
+func TestChainRuleFallback(t *testing.T) {
+	assert.False(t, IsValidChainRule("unknown"))
+	assert.Equal(t, ChainRuleLastSyllable, chainRuleByName("unknown").Name(), "Unknown rule should fall back to 끝말잇기")
+	assert.Equal(t, DefaultChainRule, RoomSettings{ChainRule: "unknown"}.normalized().ChainRule)
+}
+
+func TestIsDeadEndWordWithoutIndex(t *testing.T) {
+	g := SetupTestGame()
+
+	assert.False(t, g.isDeadEndWord("사과"), "Words should not be treated as dead ends without a dictionary index")
+}
@@ -1,59 +1,232 @@
 package game
 
+import "time"
+
 const (
     MinPlayersToStart  = 2
-    MinStartWordLength = 2
+    MinTeamCount       = 2
+    MaxTeamCount       = 4
+    DefaultTeamCount   = 2
+    DefaultTeamLives   = 3
+    DefaultPlayerLives = 1
+    DefaultChainRule   = ChainRuleLastSyllable
+    MaxLives           = 10
+    DefaultScoreRounds = 5
+    MaxScoreRounds     = 50
+    MaxScoreMinutes    = 60
+    DefaultReadyQuorum      = 100 // 시작하려면 준비해야 하는 플레이어 비율(%)
+    DefaultCountdownSeconds = 3
+    MaxCountdownSeconds     = 10
+    CountdownTick           = time.Second
+    PointsPerRune      = 10
+    MistakePenaltyPoints  = 10
+    RareWordThreshold     = 50 // 이어 낼 수 있는 사전 단어가 이 수 이하면 희귀한 단어
+    RareWordPoints        = 15
+    UncommonWordThreshold = 300
+    UncommonWordPoints    = 5
+    FastAnswerTime        = 5 * time.Second
+    FastAnswerPoints      = 10
+    NormalAnswerTime      = 10 * time.Second
+    NormalAnswerPoints    = 5
+    HintCostPoints        = 5
+    HintPrefixRunes       = 2
+    MinHintLives          = 2 // 힌트는 마지막 목숨으로 살 수 없으므로 목숨 모드에서 힌트를 켜면 이만큼은 준다.
+    MinReplaySpeed        = 0.25
+    MaxReplaySpeed        = 16.0
+    MaxReplayStates       = 500 // 게임 기록 하나에 남기는 상태 이벤트 수. 넘으면 마지막 상태만 저장할 때 붙인다.
+    SCOREMODE             = "score"
+    DefaultMaxPlayersPerRoom    = 8
+    DefaultMaxSpectatorsPerRoom = 16
+    DefaultMaxRooms             = 1000
+    DefaultMaxConnections       = 5000
+    DefaultMaxConnectionsPerIP  = 10
+    DefaultMessagesPerSecond    = 5
+    DefaultMessageBurst         = 10
+    DefaultMaxMessageViolations = 20
+    DefaultJanitorInterval      = 30 * time.Second
+    DefaultUnusedRoomTTL        = 60 * time.Second
+    DefaultIdleRoomTTL          = 30 * time.Minute
+    DefaultFinishedRoomTTL      = 10 * time.Minute
+    MinStartWordLength = 2 // 한국어 기준. 언어별 값은 store.Language 에 있다.
     MaxStartWordLength = 6
+    MaxStartWordAttempts = 10
+    GameResetDelay       = 5 * time.Second // 게임이 끝난 뒤 로비로 돌아가기까지
+    MaxCustomWordListSize = 5000
     MAXIDENTIFIER      = 9999
     MINIDENTIFIER      = 1000
     MINROOMIDIDENTIFIER = 1000
     MAXROOMIDIDENTIFIER = 9999
-    NORMALSTARTWORD    = "사과"
-    IDFALLBACK         = "0000"
-
-    WAITINGFORPLAYERSMSG = "플레이어를 기다리는 중..."
-    AVAILABLEMSG         = "새 게임을 시작할 수 있습니다. 플레이어를 기다립니다."
-    STARTMSG             = "게임이 시작되었습니다! %s님부터 시작하세요."
-    ELIMINATEDMSG        = "님이 탈락했습니다. 이유 : "
-    WINNERMSG            = "님이 승리했습니다!"
-    EXITMSG              = "님이 게임에서 나갔습니다. 다음 차례 : "
-    ALLEXITMSG           = "모든 플레이어가 나갔습니다. 새로운 플레이어를 기다립니다."
-    CURRENTTURNMSG       = "님의 차례입니다."
-
-    GAMEALREADYSTARTEDMSG = "이미 게임이 시작되었습니다."
-    NOHOSTPRIVILEGESMSG  = "게임을 시작할 권한이 없습니다. 호스트만 게임을 시작할 수 있습니다."
-    MINPLAYERTOSTARTMSG  = "게임을 시작하려면 최소 %d명의 플레이어가 필요합니다."
-    NOTTOHANDLEPLAYMSG   = "현재 게임이 시작되지 않았으므로 단어를 제출할 수 없습니다."
-    NOTCURRENTPLAYERSMSG = "현재 당신의 차례가 아닙니다."
-
-    TYPEWORDMSG        = "단어를 입력하세요."
-    MINWORDLENGTHMSG   = "단어는 최소 2자 이상이어야 합니다."
-    WORDALREADYUSEDMSG = "이미 사용된 단어입니다."
-    WORDNOTINDICTMSG   = "사전에 없는 단어입니다."
-    WORDMISMATCHMSG    = "끝말이 맞지 않습니다."
+    MaxRoomIDAttempts  = 100
+    MaxTagAttempts     = 100
+    USERIDBYTES        = 16
+    BOTNAME            = "Bot"
+    MemoryConnBuffer   = 64 // MemoryConn 이 읽기 전에 쌓아 둘 수 있는 클라이언트 메시지 수
+
+    // 사용자에게 보이는 메시지의 코드. 언어별 문장은 messages.go 의 카탈로그에 있다.
+    WAITINGFORPLAYERSMSG = "waiting_for_players"
+    AVAILABLEMSG         = "available"
+    STARTMSG             = "game_started"
+    ELIMINATEDMSG        = "player_eliminated"
+    WINNERMSG            = "player_won"
+    EXITMSG              = "player_exited"
+    ALLEXITMSG           = "all_players_exited"
+    CURRENTTURNMSG       = "current_turn"
+    TEAMNAMEMSG          = "team_name"
+    WINNERTEAMMSG        = "team_won"
+    TEAMLIFELOSTMSG      = "team_life_lost"
+    TEAMELIMINATEDMSG    = "team_eliminated"
+    LIFELOSTMSG          = "life_lost"
+    SCOREPENALTYMSG      = "score_penalty"
+    SCOREWINNERMSG       = "score_winner"
+    SCOREENDMSG          = "score_ended"
+    PLAYERREADYMSG       = "player_ready"
+    PLAYERNOTREADYMSG    = "player_not_ready"
+    COUNTDOWNMSG         = "countdown"
+    COUNTDOWNCANCELMSG = "countdown_cancelled"
+
+    GAMEALREADYSTARTEDMSG = "game_already_started"
+    NOHOSTPRIVILEGESMSG  = "not_host"
+    MINPLAYERTOSTARTMSG  = "not_enough_players"
+    NOTTOHANDLEPLAYMSG   = "game_not_started"
+    NOTCURRENTPLAYERSMSG = "not_your_turn"
+    TEAMSNOTREADYMSG     = "teams_not_ready"
+    TEAMMODEDISABLEDMSG  = "team_mode_disabled"
+    NOHOSTTEAMMSG        = "not_host_team"
+    INVALIDTEAMMSG       = "invalid_team"
+    TEAMUSERNOTFOUNDMSG  = "team_user_not_found"
+    NOHOSTBOTMSG         = "not_host_bot"
+    INVALIDBOTDIFFICULTYMSG = "invalid_bot_difficulty"
+    BOTNOTFOUNDMSG       = "bot_not_found"
+    HINTMSG              = "hint"
+    HINTUSEDMSG          = "hint_used"
+    HINTDISABLEDMSG      = "hints_disabled"
+    NOHINTMSG            = "no_hint"
+    HINTNOTAFFORDABLEMSG = "hint_not_affordable"
+    PLAYERSNOTREADYMSG   = "players_not_ready"
+    READYNOTPLAYERMSG    = "ready_not_player"
+    DICTIONARYEMPTYMSG   = "dictionary_empty"
+
+    TYPEWORDMSG        = "word_blank"
+    MINWORDLENGTHMSG   = "word_too_short"
+    WORDALREADYUSEDMSG = "word_already_used"
+    WORDNOTINDICTMSG   = "word_not_in_dict"
+    WORDMISMATCHMSG    = "word_mismatch"
+    DEADENDWORDMSG     = "word_dead_end"
+    FIRSTSYLLABLEMISMATCHMSG = "word_first_syllable_mismatch"
+    LASTTWOMISMATCHMSG       = "word_last_two_mismatch"
 
     STARTJSONTYPE   = "start_game"
     SUBMITJSONTYPE  = "submit_word"
     RESETJSONTYPE   = "reset_game"
     WELCOMEJSONTYPE = "welcome"
+    ERRORJSONTYPE   = "error"
+    SUGGESTJSONTYPE = "suggest_word"
+    NOTICEJSONTYPE  = "notice"
+    ASSIGNTEAMJSONTYPE   = "assign_team"
+    BALANCETEAMSJSONTYPE = "balance_teams"
+    ADDBOTJSONTYPE       = "add_bot"
+    REMOVEBOTJSONTYPE    = "remove_bot"
+    REQUESTHINTJSONTYPE  = "request_hint"
+    TOGGLEREADYJSONTYPE  = "toggle_ready"
+
+    ROOMFULLCODE             = "room_full"
+    JOINFAILEDCODE           = "join_failed"
+    SERVERFULLCODE           = "server_full"
+    TOOMANYCONNECTIONSCODE   = "too_many_connections"
+    ROOMNOTFOUNDCODE         = "room_not_found"
+    REPLAYNOTFOUNDCODE       = "replay_not_found"
+    KICKEDCODE               = "kicked"
+    ANNOUNCEMENTCODE         = "announcement"
+    ROOMCLOSEDCODE           = "room_closed"
+    SUGGESTIONRECEIVEDCODE   = "suggestion_received"
+    SUGGESTIONNOTALLOWEDCODE = "suggestion_not_allowed"
+    SUGGESTIONDUPLICATECODE  = "suggestion_duplicate"
+    SUGGESTIONFAILEDCODE     = "suggestion_failed"
+    RATELIMITEDCODE          = "rate_limited"
+    TOOMANYMESSAGESCODE      = "too_many_messages"
+    TEAMERRORCODE            = "team_error"
+    BOTERRORCODE             = "bot_error"
+    HINTCODE                 = "hint"
+    HINTERRORCODE            = "hint_error"
+    READYERRORCODE           = "ready_error"
+
+    ROOMFULLMSG             = "room_full"
+    JOINFAILEDMSG           = "join_failed"
+    SERVERFULLMSG           = "server_full"
+    TOOMANYCONNECTIONSMSG   = "too_many_connections"
+    ROOMNOTFOUNDMSG         = "room_not_found"
+    REPLAYNOTFOUNDMSG       = "replay_not_found"
+    ADMINENDMSG             = "admin_ended"
+    KICKEDMSG               = "kicked"
+    ANNOUNCEMENTMSG         = "announcement"
+    ROOMCLOSEDMSG           = "room_closed"
+    IDLEROOMCLOSEDMSG       = "idle_room_closed"
+    SUGGESTIONRECEIVEDMSG   = "suggestion_received"
+    SUGGESTIONNOTALLOWEDMSG = "suggestion_not_allowed"
+    SUGGESTIONDUPLICATEMSG  = "suggestion_duplicate"
+    SUGGESTIONFAILEDMSG     = "suggestion_failed"
+    RATELIMITEDMSG          = "rate_limited"
+    TOOMANYMESSAGESMSG      = "too_many_messages"
 
-    MARSHALERROR            = "marshal error"
-    UNMARSHALERROR          = "unmarshal error"
-    FAILSENDWELCOME         = "failed to send welcome to %s: %v"
-    SUBMITPAYLOADERROR      = "invalid payload for submit_word: "
-    UNKNOWNMESSAGETYPE      = "unknown message type: "
-    ENDLOGMSG               = "game reset after endGame in room %d"
-    RESETLOGMSG             = "game reset in room %d"
-    STARTLOGMSG             = "Game started in room %d"
-    BROADCASTLOGMSG         = "broadcasting state in room %d: %s"
-    HOSTLOGMSG              = "Player %s is now the host(ID : %s)."
-    HOSTCHANGELOGMSG        = "Host user changed to %s"
-    ENTERPLAYERLOGMSG       = "Player %s Enter the Game(ID : %s)"
-    EXITPLAYERLOGMSG        = "Player %s Exit the Game(ID : %s)"
-    DELETEROOMLOGMSG        = "Deleting empty room %d"
-    REMOVESPECTATORLOGMSG   = "Spectator %s removed(ID : %s)."
-    STARTINGWORDERRORLOGMSG = "Error getting random start word."
-    IDMAXATTEMPTSLOGMSG     = "Warning: generateUniqueID reached max attempts, returning fallback ID"
+    MARSHALERROR            = "marshal_error"
+    UNMARSHALERROR          = "unmarshal_error"
+    FAILSENDWELCOME         = "welcome_send_failed"
+    SUBMITPAYLOADERROR      = "invalid_submit_payload"
+    SUGGESTPAYLOADERROR     = "invalid_suggest_payload"
+    TEAMPAYLOADERROR        = "invalid_team_payload"
+    BOTPAYLOADERROR         = "invalid_bot_payload"
+    FAILSENDNOTICE          = "notice_send_failed"
+    UNKNOWNMESSAGETYPE      = "unknown_message_type"
+    ENDLOGMSG               = "game_ended"
+    RESETLOGMSG             = "game_reset"
+    STARTLOGMSG             = "game_started"
+    BROADCASTLOGMSG         = "state_broadcast"
+    HOSTLOGMSG              = "host_assigned"
+    HOSTCHANGELOGMSG        = "host_changed"
+    ENTERPLAYERLOGMSG       = "player_entered"
+    ENTERSPECTATORLOGMSG    = "spectator_entered"
+    REJECTCONNECTIONLOGMSG  = "connection_rejected"
+    EXITPLAYERLOGMSG        = "player_exited"
+    DELETEROOMLOGMSG        = "empty_room_deleted"
+    REMOVESPECTATORLOGMSG   = "spectator_removed"
+    STARTINGWORDERRORLOGMSG = "start_word_failed"
+    DEADENDSTARTWORDLOGMSG  = "start_word_dead_end"
+    SUGGESTIONLOGMSG        = "word_suggested"
+    SUGGESTIONERRORLOGMSG   = "word_suggestion_failed"
+    FORCEENDLOGMSG          = "game_force_ended"
+    KICKLOGMSG              = "player_kicked"
+    CLOSEROOMLOGMSG         = "room_closed"
+    REAPROOMLOGMSG          = "idle_room_reaped"
+    TEAMASSIGNLOGMSG        = "team_assigned"
+    BOTADDEDLOGMSG          = "bot_added"
+    BOTREMOVEDLOGMSG        = "bot_removed"
+    BOTPLAYLOGMSG           = "bot_played"
+    HINTLOGMSG              = "hint_requested"
+    TEAMPENALTYLOGMSG       = "team_penalized"
+    LIFELOSTLOGMSG          = "life_lost"
+    SAVERESULTLOGMSG        = "game_result_saved"
+    SAVERESULTERRORLOGMSG   = "game_result_save_failed"
+    SAVEREPLAYLOGMSG        = "game_replay_saved"
+    SAVEREPLAYERRORLOGMSG   = "game_replay_save_failed"
+    ANNOUNCELOGMSG          = "announcement_sent"
+    MAINTENANCELOGMSG       = "maintenance_changed"
+    WORDLISTSETLOGMSG       = "word_list_set"
+    IDMAXATTEMPTSLOGMSG     = "id_attempts_exhausted"
+    USERIDERRORLOGMSG       = "user_id_failed"
+    ROOMCREATEDLOGMSG       = "room_created"
+    USERJOINEDLOGMSG        = "room_joined"
+    USERLEFTLOGMSG          = "room_left"
+    BROADCASTERRORLOGMSG    = "broadcast_failed"
+    READLOOPENDLOGMSG       = "read_loop_ended"
+    READERRORLOGMSG         = "read_failed"
+    MESSAGERECEIVEDLOGMSG   = "message_received"
+    RATELIMITEDLOGMSG       = "message_rate_limited"
+    ABUSEDISCONNECTLOGMSG   = "abusive_client_disconnected"
+    PHASELOGMSG             = "phase_changed"
+    PHASEERRORLOGMSG        = "phase_transition_rejected"
+    READYLOGMSG             = "player_ready_changed"
+    COUNTDOWNLOGMSG         = "countdown_started"
+    COUNTDOWNCANCELLOGMSG   = "countdown_cancelled"
 
 	IDSUFFIX                 = "#"
 )
\ No newline at end of file
@@ -0,0 +1,264 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"wordgame/internal/i18n"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const flowTimeout = 2 * time.Second
+
+type flowState struct {
+	LastWord      string         `json:"lastWord"`
+	Players       []PlayerInfo   `json:"players"`
+	Spectators    []PlayerInfo   `json:"spectators"`
+	CurrentTurn   string         `json:"currentTurnPlayerId"`
+	Phase         Phase          `json:"phase"`
+	IsGameOver    bool           `json:"isGameOver"`
+	IsStarted     bool           `json:"isStarted"`
+	MessageCode   string         `json:"messageCode"`
+	MessageParams map[string]any `json:"messageParams"`
+}
+
+type flowClient struct {
+	t    *testing.T
+	conn *MemoryConn
+	id   string // 세션 ID. 본인만 안다.
+	tag  string // 상태에 보이는 공개 ID
+}
+
+// connectFlowClient 는 메모리 연결로 방에 들어가 환영 메시지에서 세션 ID 와 공개 ID 를 받는다.
+func connectFlowClient(t *testing.T, g *Game, name string) *flowClient {
+	c := &flowClient{t: t, conn: NewMemoryConn()}
+	go g.AddClient(c.conn, name, "ko")
+	for {
+		msg, err := c.conn.Next(flowTimeout)
+		require.NoError(t, err, "%s should receive a welcome message", name)
+		var welcome WelcomeMessage
+		if json.Unmarshal(msg, &welcome) == nil && welcome.Type == WELCOMEJSONTYPE {
+			c.id, c.tag = welcome.YourId, welcome.YourTag
+			return c
+		}
+	}
+}
+
+func (c *flowClient) send(msgType string, payload any) {
+	bytes, _ := json.Marshal(GameMessage{Type: msgType, Payload: payload})
+	require.NoError(c.t, c.conn.Send(bytes))
+}
+
+// waitState 는 조건에 맞는 상태 메시지가 올 때까지 기다린다. 알림과 환영 메시지는 건너뛴다.
+func (c *flowClient) waitState(match func(flowState) bool) flowState {
+	for {
+		msg, err := c.conn.Next(flowTimeout)
+		require.NoError(c.t, err, "expected state was not received")
+		var typed struct {
+			Type string `json:"type"`
+		}
+		if json.Unmarshal(msg, &typed) != nil || typed.Type != "" {
+			continue
+		}
+		var state flowState
+		require.NoError(c.t, json.Unmarshal(msg, &state))
+		if match(state) {
+			return state
+		}
+	}
+}
+
+// messageCodes 는 상태 메시지의 코드를 돌려준다. 이어 붙인 메시지면 각 부분의 코드를 돌려준다.
+func messageCodes(code string, params map[string]any) []string {
+	if code != i18n.SequenceCode {
+		return []string{code}
+	}
+	var codes []string
+	parts, _ := params["parts"].([]any)
+	for _, part := range parts {
+		if m, ok := part.(map[string]any); ok {
+			codes = append(codes, fmt.Sprint(m["code"]))
+		}
+	}
+	return codes
+}
+
+func allReady(players []PlayerInfo) bool {
+	for _, p := range players {
+		if !p.Ready {
+			return false
+		}
+	}
+	return true
+}
+
+func findFlowClient(clients []*flowClient, tag string) *flowClient {
+	for _, c := range clients {
+		if c.tag == tag {
+			return c
+		}
+	}
+	return nil
+}
+
+// nextTestWord 는 TestWords 에서 last 에 이어지는, 쓰지 않은 단어를 고른다.
+func nextTestWord(last string, used map[string]bool) string {
+	tail, _ := utf8.DecodeLastRuneInString(last)
+	for _, w := range TestWords {
+		head, _ := utf8.DecodeRuneInString(w)
+		if head == tail && !used[w] && w != last {
+			return w
+		}
+	}
+	return ""
+}
+
+// startFlowGame 은 players 명을 접속시켜 모두 준비하게 하고, 방장이 시작해 카운트다운이 끝난 뒤의 상태를 돌려준다.
+func startFlowGame(t *testing.T, players int) (*Game, []*flowClient, flowState) {
+	g := NewTestGame()
+	names := []string{"Alice", "Bob", "Charlie", "Dave"}
+	clients := make([]*flowClient, players)
+	for i := range clients {
+		clients[i] = connectFlowClient(t, g, names[i])
+	}
+	t.Cleanup(func() {
+		for _, c := range clients {
+			_ = c.conn.Close()
+		}
+	})
+
+	for _, c := range clients {
+		c.send(TOGGLEREADYJSONTYPE, nil)
+	}
+	clients[0].waitState(func(s flowState) bool { return len(s.Players) == players && allReady(s.Players) })
+	clients[0].send(STARTJSONTYPE, nil)
+	clients[0].waitState(func(s flowState) bool { return s.Phase == PhaseCountdown })
+	FakeClock(g).Advance(CountdownTick * DefaultCountdownSeconds)
+	state := clients[0].waitState(func(s flowState) bool { return s.Phase == PhaseInTurn })
+	require.Len(t, state.Players, players)
+	return g, clients, state
+}
+
+func TestGameFlows(t *testing.T) {
+	testCases := []struct {
+		name    string
+		players int
+		play    func(t *testing.T, g *Game, clients []*flowClient, start flowState)
+	}{
+		{
+			name:    "valid chain passes the turn",
+			players: 2,
+			play: func(t *testing.T, g *Game, clients []*flowClient, start flowState) {
+				current := findFlowClient(clients, start.CurrentTurn)
+				word := nextTestWord(start.LastWord, map[string]bool{start.LastWord: true})
+				require.NotEmpty(t, word)
+
+				current.send(SUBMITJSONTYPE, word)
+				state := clients[0].waitState(func(s flowState) bool { return s.LastWord == word })
+
+				assert.NotEqual(t, current.tag, state.CurrentTurn, "Turn should move to the other player")
+				assert.Equal(t, CURRENTTURNMSG, state.MessageCode)
+			},
+		},
+		{
+			name:    "used word ends a two player game",
+			players: 2,
+			play: func(t *testing.T, g *Game, clients []*flowClient, start flowState) {
+				current := findFlowClient(clients, start.CurrentTurn)
+
+				current.send(SUBMITJSONTYPE, start.LastWord)
+				state := clients[0].waitState(func(s flowState) bool { return s.IsGameOver })
+
+				assert.Equal(t, WINNERMSG, state.MessageCode)
+				assert.NotEqual(t, current.tag, state.MessageParams["playerId"], "The other player should win")
+				require.Eventually(t, func() bool {
+					return len(g.store.(*MemoryStore).Replays()) == 1
+				}, flowTimeout, 10*time.Millisecond, "Finished game should be saved as a replay")
+			},
+		},
+		{
+			name:    "mismatched word eliminates the player",
+			players: 3,
+			play: func(t *testing.T, g *Game, clients []*flowClient, start flowState) {
+				current := findFlowClient(clients, start.CurrentTurn)
+
+				current.send(SUBMITJSONTYPE, "바나나")
+				state := clients[0].waitState(func(s flowState) bool { return len(s.Spectators) == 1 })
+
+				assert.Equal(t, []string{ELIMINATEDMSG, STARTMSG}, messageCodes(state.MessageCode, state.MessageParams), "Elimination should start a new round")
+				assert.Equal(t, current.tag, state.Spectators[0].ID)
+				assert.False(t, state.IsGameOver, "Two players are still left")
+				assert.NotEqual(t, current.tag, state.CurrentTurn, "New round should start with a remaining player")
+			},
+		},
+		{
+			name:    "word outside the dictionary eliminates the player",
+			players: 3,
+			play: func(t *testing.T, g *Game, clients []*flowClient, start flowState) {
+				current := findFlowClient(clients, start.CurrentTurn)
+				tail, _ := utf8.DecodeLastRuneInString(start.LastWord)
+
+				current.send(SUBMITJSONTYPE, string(tail)+"뷁뷁")
+				state := clients[0].waitState(func(s flowState) bool { return len(s.Spectators) == 1 })
+
+				assert.Equal(t, current.tag, state.Spectators[0].ID)
+			},
+		},
+		{
+			name:    "disconnect mid-turn passes the turn",
+			players: 3,
+			play: func(t *testing.T, g *Game, clients []*flowClient, start flowState) {
+				current := findFlowClient(clients, start.CurrentTurn)
+				var other *flowClient
+				for _, c := range clients {
+					if c != current {
+						other = c
+						break
+					}
+				}
+
+				_ = current.conn.Close()
+				state := other.waitState(func(s flowState) bool { return len(s.Players) == 2 })
+
+				assert.NotEqual(t, current.tag, state.CurrentTurn, "Turn should move on when the current player leaves")
+				assert.True(t, state.IsStarted, "Game should go on with the remaining players")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g, clients, start := startFlowGame(t, tc.players)
+			tc.play(t, g, clients, start)
+		})
+	}
+}
+
+func TestSeededGameIsReproducible(t *testing.T) {
+	first, second := SetupDefaultPlayers(), SetupDefaultPlayers()
+
+	startReadyGame(first, first.players[0])
+	startReadyGame(second, second.players[0])
+
+	assert.Equal(t, first.startword, second.startword, "Same seed should pick the same start word")
+	assert.Equal(t, first.currentUserID, second.currentUserID, "Same seed should pick the same first player")
+}
+
+func TestStateHidesSessionIDs(t *testing.T) {
+	g, clients, _ := startFlowGame(t, 2)
+
+	g.mu.Lock()
+	bytes, err := json.Marshal(g.makeSendForm(i18n.LocaleKorean, g.makePlayerList(), g.makeSpectatorList(), g.makeHintInfo()))
+	g.mu.Unlock()
+
+	require.NoError(t, err)
+	for _, c := range clients {
+		assert.NotContains(t, string(bytes), c.id, "Session IDs should only be sent in the welcome message")
+		assert.Contains(t, string(bytes), c.tag, "Players should be identified by their public ID")
+	}
+}
@@ -1,30 +1,67 @@
 package game
 
 import (
+	"log/slog"
 	"sync"
-	"wordgame/internal/random"
-	"wordgame/internal/store"
+	"time"
 
+	"wordgame/internal/clock"
+	"wordgame/internal/i18n"
+	"wordgame/internal/logging"
 )
 
 type Game struct {
-	room          *Room
-	RoomName      string
-	RoomId        int
-	manager       *RoomManager
-	hostUserId    string
-	lastWord      string
-	startword     string
-	usedWords     map[string]bool
-	players       []*User
-	spectators    []*User
-	currentUserID string
-	gameover      bool
-	started       bool
-	message       string
-	mu            sync.Mutex
-	store         *store.DBManager
-	random        *random.Manager
+	room           *Room
+	RoomName       string
+	RoomId         int
+	manager        *RoomManager
+	hostUserId     string
+	lastWord       string
+	startword      string
+	usedWords      map[string]bool
+	rejectedWords  map[string]string
+	players        []*User
+	spectators     []*User
+	currentUserID  string
+	state          StateMachine
+	message        i18n.Message
+	settings       RoomSettings
+	limits         Limits
+	wordList       *CustomWordList
+	lives          map[string]int
+	scores         map[string]*ScoreBreakdown
+	standings      []ScoreBreakdown
+	scoreTurns     int
+	scoreTimer     clock.Timer
+	turnStartedAt  time.Time
+	botTimer       clock.Timer
+	resetTimer     clock.Timer
+	ready          map[string]bool
+	countdown      int // 카운트다운 중 남은 초
+	countdownTimer clock.Timer
+	countdownRun   int
+	botTurn        int
+	replay         *replayRecorder
+	replayID       uint
+	teams          map[string]int
+	teamLives      []int
+	teamScores     []int
+	teamCursor     []int
+	currentTeam    int
+	createdAt      time.Time
+	lastActivity   time.Time
+	finishedAt     time.Time
+	connected      bool
+	mu             sync.Mutex
+	store          Store
+	random         Random
+	clock          clock.Clock
+	logger         *slog.Logger
+}
+
+// Random 은 게임이 쓰는 난수원이다. random.Manager 가 구현한다.
+type Random interface {
+	MakeRandomNumber(min, max int) int
 }
 
 type GameMessage struct {
@@ -32,23 +69,37 @@ type GameMessage struct {
 	Payload any    `json:"payload"`
 }
 
-func NewGame(roomname string, roomId int, manager *RoomManager, rnd *random.Manager, store *store.DBManager) *Game {
+func NewGame(roomname string, roomId int, manager *RoomManager, rnd Random, store Store) *Game {
+	logger := manager.logger.With(logging.RoomIDKey, roomId)
+
 	//게임 생성시 룸도 같이 생성되게.
-	room := NewRoom()
+	room := NewRoom(logger)
 	go room.Run()
+	now := manager.clock.Now()
 
 	return &Game{
-		room:       room,
-		RoomName:   roomname,
-		RoomId:     roomId,
-		manager:    manager,
-		usedWords:  make(map[string]bool),
-		players:    make([]*User, 0),
-		spectators: make([]*User, 0),
-		message:    WAITINGFORPLAYERSMSG,
-		startword:  "",
-		started:    false, //로비상태로 유지.
-		random:     rnd,
-		store:      store,
+		room:          room,
+		RoomName:      roomname,
+		RoomId:        roomId,
+		manager:       manager,
+		usedWords:     make(map[string]bool),
+		rejectedWords: make(map[string]string),
+		lives:         make(map[string]int),
+		scores:        make(map[string]*ScoreBreakdown),
+		teams:         make(map[string]int),
+		ready:         make(map[string]bool),
+		players:       make([]*User, 0),
+		spectators:    make([]*User, 0),
+		message:       i18n.New(WAITINGFORPLAYERSMSG),
+		settings:      DefaultRoomSettings(),
+		limits:        manager.limits,
+		createdAt:     now,
+		lastActivity:  now,
+		startword:     "",
+		state:         NewStateMachine(), //로비상태로 유지.
+		random:        rnd,
+		clock:         manager.clock,
+		store:         store,
+		logger:        logger,
 	}
-}
\ No newline at end of file
+}
@@ -1,17 +1,19 @@
 package game
 
 import (
-	"fmt"
-	"log"
-	"strings"
-	"time"
 	"unicode/utf8"
+
+	"wordgame/internal/i18n"
+	"wordgame/internal/logging"
+	"wordgame/internal/metrics"
+	"wordgame/internal/store"
 )
 
 func (g *Game) handlePlay(user *User, word string) {
+	metrics.WordsSubmitted.Inc()
 	g.mu.Lock()
 
-	if g.handleGameAlreadyStarted() {
+	if g.handleTurnNotOpen() {
 		return
 	}
 
@@ -19,7 +21,7 @@ func (g *Game) handlePlay(user *User, word string) {
 		return
 	}
 
-	word = strings.TrimSpace(word)
+	word = g.dictionary().Normalize(word)
 	if g.handleWordIsBlank(word) {
 		return
 	}
@@ -35,85 +37,158 @@ func (g *Game) handlePlay(user *User, word string) {
 	if g.handleWordIsNotInDB(user, word) {
 		return
 	}
+	if g.handleWordIsDeadEnd(user, word) {
+		return
+	}
 
 	g.handleNextTurn(user, word)
 }
 
-func (g *Game) endGame(message string) {
+func (g *Game) endGame(message i18n.Message) {
 	g.mu.Lock()
-	g.gameover = true
+	// 우승 확인에서 이미 GameOver 로 옮겼을 수 있다.
+	if !g.isGameOver() {
+		g.transition(PhaseEventFinish)
+	}
 	g.message = message
+	g.finishedAt = g.clock.Now()
+	g.recordReplay(ReplayEvent{Type: ReplayEventEnd, Message: &message})
+	g.lastActivity = g.finishedAt
 	g.mu.Unlock()
-	log.Printf(RESETLOGMSG, g.RoomId)
+	metrics.GamesFinished.Inc()
+	logging.Info(g.logger, ENDLOGMSG, "message", message.Code)
 	g.broadcastGameState()
+	if g.settings.ScoreMode {
+		g.saveStandings()
+	}
+	g.saveReplay()
 
 	//5초 후에 게임 리셋
-	go func() {
-		time.Sleep(5 * time.Second)
+	g.mu.Lock()
+	g.stopResetTimer()
+	g.resetTimer = g.clock.AfterFunc(GameResetDelay, func() {
 		g.mu.Lock()
+		g.resetTimer = nil
 		g.reset()
 		g.mu.Unlock()
 		g.broadcastGameState()
-	}()
+	})
+	g.mu.Unlock()
+}
+
+func (g *Game) stopResetTimer() {
+	if g.resetTimer != nil {
+		g.resetTimer.Stop()
+		g.resetTimer = nil
+	}
 }
 
 func (g *Game) reset() {
-	if g.gameover {
-		g.players = append(g.players, g.spectators...)
-		g.spectators = make([]*User, 0)
+	if g.isGameOver() {
+		g.promoteSpectators()
 	}
 
+	g.stopScoreTimer()
+	g.stopBotTimer()
+	g.stopResetTimer()
+	g.stopCountdownTimer()
+	g.ready = make(map[string]bool)
+	g.replay = nil
+	g.standings = nil
 	g.startword = ""
 	g.lastWord = ""
 	g.usedWords = make(map[string]bool)
-	g.gameover = false
-	g.started = false
+	g.transition(PhaseEventReset)
 	g.currentUserID = ""
-	g.message = AVAILABLEMSG
-	log.Printf(RESETLOGMSG, g.RoomId)
+	g.message = i18n.New(AVAILABLEMSG)
+	logging.Info(g.logger, RESETLOGMSG)
 }
 
 func (g *Game) startGame(user *User) {
 	g.mu.Lock()
 	defer g.mu.Unlock()
 
-	if g.started {
-		g.message = GAMEALREADYSTARTEDMSG
+	if g.isStarted() {
+		g.message = i18n.New(GAMEALREADYSTARTEDMSG)
 		return
 	} else if g.hostUserId != user.ID {
-		g.message = NOHOSTPRIVILEGESMSG
+		g.message = i18n.New(NOHOSTPRIVILEGESMSG)
 		return
 	} else if len(g.players) < MinPlayersToStart {
-		g.message = fmt.Sprintf(MINPLAYERTOSTARTMSG, MinPlayersToStart)
+		g.message = i18n.New(MINPLAYERTOSTARTMSG, "count", MinPlayersToStart)
+		return
+	} else if !g.hasPlayableDictionary() {
+		g.message = i18n.New(DICTIONARYEMPTYMSG, "language", g.settings.Language)
+		return
+	}
+	// 방장이 시작을 누르면 준비된 것으로 본다.
+	g.ready[user.ID] = true
+	if ready, needed := g.readyCount(), g.readyNeeded(); ready < needed {
+		g.message = i18n.New(PLAYERSNOTREADYMSG, "ready", ready, "needed", needed)
 		return
 	}
+	if g.settings.TeamMode {
+		if err := g.prepareTeams(); err != nil {
+			g.message = i18n.New(TEAMSNOTREADYMSG, "count", MinTeamCount)
+			return
+		}
+	}
 
-	g.startNewRound()
+	g.beginCountdown()
 }
 
+// startNewRound 는 카운트다운이 끝났거나 탈락자가 나온 뒤 새 시작 단어로 라운드를 연다.
+// 탈락으로 끝난 라운드라면 탈락 메시지를 시작 메시지 앞에 붙인다.
 func (g *Game) startNewRound() {
 	if len(g.players) == 0 {
 		g.reset()
 		return
 	}
+	previous := g.state.Phase()
+	if !g.transition(PhaseEventRoundStart) {
+		return
+	}
 
-	randomPlayerIndex := g.selectRandomPlayerIndex()
+	first := g.selectFirstPlayer()
 	g.startword = g.makeStartWord()
 	g.lastWord = g.startword
 	g.usedWords = make(map[string]bool)
 	g.usedWords[g.startword] = true
-	g.started = true
-	g.gameover = false
-	g.currentUserID = g.players[randomPlayerIndex].ID
-	g.message = fmt.Sprintf(STARTMSG, g.makeNameToDisplay(g.currentUserID, g.players[randomPlayerIndex].Name))
-	log.Printf(STARTLOGMSG, g.RoomId)
+	g.turnStartedAt = g.clock.Now()
+	g.currentUserID = first.ID
+	if previous == PhaseRoundOver {
+		g.message = i18n.Join(g.message, g.playerMessage(STARTMSG, first))
+	} else {
+		g.message = g.playerMessage(STARTMSG, first)
+	}
+	g.recordReplay(ReplayEvent{Type: ReplayEventStart, UserID: first.Tag, Word: g.startword})
+	logging.Info(g.logger, STARTLOGMSG, "start_word", g.startword, "players", len(g.players))
 }
 
-func (g *Game) eliminatePlayer(user *User, reason string) (winner bool, winnerMsg string) {
-	eliminated := false
+// selectFirstPlayer 는 첫 차례를 정한다. 팀 모드에서는 무작위 팀의 첫 플레이어부터 시작한다.
+func (g *Game) selectFirstPlayer() *User {
+	if g.settings.TeamMode {
+		return g.firstTeamTurn()
+	}
+	return g.players[g.selectRandomPlayerIndex()]
+}
 
+func (g *Game) eliminatePlayer(user *User, reason i18n.Message) (winner bool, winnerMsg i18n.Message) {
+	if g.settings.TeamMode {
+		return g.penalizeTeam(user, reason)
+	}
+	if g.settings.ScoreMode {
+		return g.penalizeScore(user, reason)
+	}
+	if g.loseLife(user, reason) {
+		return false, i18n.Message{}
+	}
+	eliminated := false
 	for i, p := range g.players {
-		g.handleUserElimination(p, user, i, reason)
+		if g.handleUserElimination(p, user, i, reason) {
+			eliminated = true
+			break
+		}
 	}
 
 	winner, msg := g.handleWinnnerCheck()
@@ -121,30 +196,138 @@ func (g *Game) eliminatePlayer(user *User, reason string) (winner bool, winnerMs
 		return true, msg
 	}
 
-	if eliminated {
+	if eliminated && g.transition(PhaseEventPlayerOut) {
 		g.startNewRound()
 	}
 
-	return false, ""
+	return false, i18n.Message{}
 }
 
 func (g *Game) makeStartWord() string {
-	randomWordLength := g.random.MakeRandomNumber(MinStartWordLength, MaxStartWordLength) // 2자에서 6자 사이
-	word, err := g.store.GetRandomWordByLength(randomWordLength)
-	if err != nil {
-		log.Println(STARTINGWORDERRORLOGMSG, err)
-		return NORMALSTARTWORD
+	if g.wordList != nil && g.wordList.Mode == store.WordListModeAllow {
+		return g.makeStartWordFromList()
 	}
-	return word
+
+	// 한방 단어로 시작하면 첫 차례부터 이을 수 없으므로 다시 뽑는다.
+	for i := 0; i < MaxStartWordAttempts; i++ {
+		lang := g.dictionary().Language()
+		randomWordLength := g.random.MakeRandomNumber(lang.MinStartWordLength, lang.MaxStartWordLength)
+		word, err := g.dictionary().GetRandomWordByLength(randomWordLength)
+		if err != nil {
+			logging.Error(g.logger, STARTINGWORDERRORLOGMSG, logging.ErrorKey, err)
+			return g.dictionary().Language().StartWord
+		}
+		if allowed, _ := g.isWordAllowedByList(word); !allowed {
+			continue
+		}
+		if !g.isDeadEndWord(word) {
+			return word
+		}
+		logging.Debug(g.logger, DEADENDSTARTWORDLOGMSG, "word", word)
+	}
+	return g.dictionary().Language().StartWord
+}
+
+func (g *Game) makeStartWordFromList() string {
+	words := g.wordList.Words()
+	return words[g.random.MakeRandomNumber(0, len(words))]
+}
+
+// hasPlayableDictionary 는 시작 단어를 뽑고 이어 낼 사전 단어가 있는지 본다.
+// 허용 목록을 쓰는 방은 목록만으로 게임을 할 수 있다.
+func (g *Game) hasPlayableDictionary() bool {
+	if g.wordList != nil && g.wordList.Mode == store.WordListModeAllow {
+		return true
+	}
+	return !IsDictionaryEmpty(g.dictionary())
+}
+
+// IsDictionaryEmpty 는 사전 색인이 올라왔는데 단어가 하나도 없는지 본다.
+// 색인이 없으면 DB 조회로 게임을 할 수 있으므로 비어 있다고 보지 않는다.
+func IsDictionaryEmpty(d Dictionary) bool {
+	count, ok := d.WordCount()
+	return ok && count == 0
+}
+
+// dictionary 는 방 언어에 맞는 사전을 돌려준다.
+func (g *Game) dictionary() Dictionary {
+	return g.store.Dictionary(g.settings.Language)
+}
+
+func (g *Game) chainRule() ChainRule {
+	return chainRuleByName(g.settings.ChainRule)
+}
+
+// isDeadEndWord 는 방의 잇기 규칙으로 이 단어 다음에 이어질 사전 단어가 없는지 확인한다.
+func (g *Game) isDeadEndWord(word string) bool {
+	count, ok := g.continuationCount(word)
+	return ok && count == 0
+}
+
+// continuationCount 는 방의 잇기 규칙으로 이 단어 다음에 이어 낼 수 있는 사전 단어 수를 센다.
+// 끝을 뽑을 수 없는 단어이거나 사전 색인이 없으면 false 를 돌려준다.
+func (g *Game) continuationCount(word string) (int, bool) {
+	rule := g.chainRule()
+	tail := rule.Tail(g.dictionary().Normalize(word))
+	if tail == "" {
+		return 0, false
+	}
+	return g.dictionary().CountWordsByKey(rule.Name(), rule.Head, tail)
+}
+
+// nextWordCandidates 는 방 규칙으로 앞 단어에 이어 낼 수 있는, 아직 쓰지 않은 단어들을 찾는다.
+func (g *Game) nextWordCandidates() []string {
+	rule := g.chainRule()
+	tail := rule.Tail(g.lastWord)
+	if tail == "" {
+		return nil
+	}
+
+	var words []string
+	if g.wordList != nil && g.wordList.Mode == store.WordListModeAllow {
+		for _, w := range g.wordList.Words() {
+			if rule.Head(w) == tail {
+				words = append(words, w)
+			}
+		}
+	} else {
+		words, _ = g.dictionary().WordsByKey(rule.Name(), rule.Head, tail)
+	}
+
+	minLength := g.dictionary().Language().MinWordLength
+	candidates := make([]string, 0, len(words))
+	for _, w := range words {
+		if g.usedWords[w] || utf8.RuneCountInString(w) < minLength {
+			continue
+		}
+		if allowed, _ := g.isWordAllowedByList(w); !allowed {
+			continue
+		}
+		if g.settings.BanDeadEndWords && g.isDeadEndWord(w) {
+			continue
+		}
+		candidates = append(candidates, w)
+	}
+	return candidates
 }
 
 func (g *Game) wordDBCheck(word string) bool {
-	return g.store.IsWordInDB(word)
+	allowed, checkDict := g.isWordAllowedByList(word)
+	if !allowed {
+		return false
+	}
+	if !checkDict {
+		return true
+	}
+	return g.dictionary().IsWordInDB(word)
 }
 
-func (g *Game) handleGameAlreadyStarted() bool {
-	if !g.started {
-		g.message = NOTTOHANDLEPLAYMSG
+// handleTurnNotOpen 은 단어를 받을 수 있는 단계가 아니면 거절한다.
+// 로비, 카운트다운, 게임이 끝난 뒤에 온 단어는 모두 여기서 걸러진다.
+func (g *Game) handleTurnNotOpen() bool {
+	if !g.isTurnOpen() {
+		recordRejection(NOTTOHANDLEPLAYMSG)
+		g.message = i18n.New(NOTTOHANDLEPLAYMSG)
 		g.mu.Unlock()
 		g.broadcastGameState()
 		return true
@@ -154,7 +337,8 @@ func (g *Game) handleGameAlreadyStarted() bool {
 
 func (g *Game) handleUserIsNotCurrentTurn(id string) bool {
 	if g.currentUserID != id {
-		g.message = NOTCURRENTPLAYERSMSG
+		recordRejection(NOTCURRENTPLAYERSMSG)
+		g.message = i18n.New(NOTCURRENTPLAYERSMSG)
 		g.mu.Unlock()
 		g.broadcastGameState()
 		return true
@@ -164,7 +348,8 @@ func (g *Game) handleUserIsNotCurrentTurn(id string) bool {
 
 func (g *Game) handleWordIsBlank(word string) bool {
 	if word == "" {
-		g.message = TYPEWORDMSG
+		g.rejectWord(word, TYPEWORDMSG)
+		g.message = i18n.New(TYPEWORDMSG)
 		g.mu.Unlock()
 		g.broadcastGameState()
 		return true
@@ -173,8 +358,10 @@ func (g *Game) handleWordIsBlank(word string) bool {
 }
 
 func (g *Game) handleWordIsNotEnoughLength(word string) bool {
-	if utf8.RuneCountInString(word) < 2 {
-		g.message = MINWORDLENGTHMSG
+	minLength := g.dictionary().Language().MinWordLength
+	if utf8.RuneCountInString(word) < minLength {
+		g.rejectWord(word, MINWORDLENGTHMSG)
+		g.message = i18n.New(MINWORDLENGTHMSG, "length", minLength)
 		g.mu.Unlock()
 		g.broadcastGameState()
 		return true
@@ -182,7 +369,7 @@ func (g *Game) handleWordIsNotEnoughLength(word string) bool {
 	return false
 }
 
-func (g *Game) handleEndGameOrContinue(winner bool, msg string) {
+func (g *Game) handleEndGameOrContinue(winner bool, msg i18n.Message) {
 	if winner {
 		g.endGame(msg)
 	} else {
@@ -192,7 +379,8 @@ func (g *Game) handleEndGameOrContinue(winner bool, msg string) {
 
 func (g *Game) handleWordIsAlreadyUsed(user *User, word string) bool {
 	if g.usedWords[word] {
-		winner, msg := g.eliminatePlayer(user, WORDALREADYUSEDMSG)
+		g.rejectWord(word, WORDALREADYUSEDMSG)
+		winner, msg := g.eliminatePlayer(user, i18n.New(WORDALREADYUSEDMSG, "word", word))
 		g.mu.Unlock()
 		g.handleEndGameOrContinue(winner, msg)
 		return true
@@ -201,10 +389,10 @@ func (g *Game) handleWordIsAlreadyUsed(user *User, word string) bool {
 }
 
 func (g *Game) handleWordChainRuleDismatch(user *User, word string) bool {
-	lastRune, _ := utf8.DecodeLastRuneInString(g.lastWord)
-	firstRune, _ := utf8.DecodeRuneInString(word)
-	if lastRune != firstRune {
-		winner, msg := g.eliminatePlayer(user, WORDMISMATCHMSG)
+	rule := g.chainRule()
+	if !isChainMatched(rule, g.lastWord, word) {
+		g.rejectWord(word, WORDMISMATCHMSG)
+		winner, msg := g.eliminatePlayer(user, i18n.New(rule.MismatchMessage(), "word", word))
 		g.mu.Unlock()
 		g.handleEndGameOrContinue(winner, msg)
 		return true
@@ -214,7 +402,20 @@ func (g *Game) handleWordChainRuleDismatch(user *User, word string) bool {
 
 func (g *Game) handleWordIsNotInDB(user *User, word string) bool {
 	if !g.wordDBCheck(word) {
-		winner, msg := g.eliminatePlayer(user, WORDNOTINDICTMSG)
+		g.rememberRejectedWord(user, word)
+		g.rejectWord(word, WORDNOTINDICTMSG)
+		winner, msg := g.eliminatePlayer(user, i18n.New(WORDNOTINDICTMSG, "word", word))
+		g.mu.Unlock()
+		g.handleEndGameOrContinue(winner, msg)
+		return true
+	}
+	return false
+}
+
+func (g *Game) handleWordIsDeadEnd(user *User, word string) bool {
+	if g.settings.BanDeadEndWords && g.isDeadEndWord(word) {
+		g.rejectWord(word, DEADENDWORDMSG)
+		winner, msg := g.eliminatePlayer(user, i18n.New(DEADENDWORDMSG, "word", word))
 		g.mu.Unlock()
 		g.handleEndGameOrContinue(winner, msg)
 		return true
@@ -223,30 +424,49 @@ func (g *Game) handleWordIsNotInDB(user *User, word string) bool {
 }
 
 func (g *Game) handleNextTurn(user *User, word string) {
+	metrics.WordsAccepted.Inc()
+	g.recordReplay(ReplayEvent{Type: ReplayEventSubmit, UserID: user.Tag, Word: word, Outcome: ReplayOutcomeAccepted})
 	g.lastWord = word
 	g.usedWords[word] = true
-	g.setNextPlayerTurn(user.ID)
+	if g.settings.ScoreMode {
+		g.addWordScore(user, word)
+	}
+	if g.settings.TeamMode {
+		g.addTeamScore(user)
+		g.setNextTeamTurn()
+	} else {
+		g.setNextPlayerTurn(user.ID)
+	}
+	finished, msg := g.advanceScoreTurn()
 	g.mu.Unlock()
-	g.broadcastGameState()
+	g.handleEndGameOrContinue(finished, msg)
 }
 
-func (g *Game) handleUserElimination(user *User, target *User, index int, reason string) {
-	if target.ID == user.ID {
-		g.players = append(g.players[:index], g.players[index+1:]...)
-		g.spectators = append(g.spectators, user)
-		g.message = g.makeNameToDisplay(user.ID, user.Name) + ELIMINATEDMSG + reason
+// handleUserElimination 은 target 이 탈락할 플레이어면 관전자로 옮기고 true 를 돌려준다.
+func (g *Game) handleUserElimination(user *User, target *User, index int, reason i18n.Message) bool {
+	if target.ID != user.ID {
+		return false
 	}
+	g.players = append(g.players[:index], g.players[index+1:]...)
+	g.spectators = append(g.spectators, user)
+	g.message = g.playerMessage(ELIMINATEDMSG, user, "reason", reason)
+	g.recordReplay(ReplayEvent{Type: ReplayEventEliminate, UserID: user.Tag, Message: &reason})
+	return true
 }
 
-func (g *Game) handleWinnnerCheck() (bool, string) {
+func (g *Game) handleWinnnerCheck() (bool, i18n.Message) {
 	// 승리 조건: 활성 플레이어가 한 명이면 우승 처리 (잠금은 호출자가 관리)
+	// 팀 모드에서는 목숨이 남은 팀이 하나면 그 팀이 우승한다.
+	if g.settings.TeamMode {
+		return g.handleWinningTeamCheck()
+	}
 	if len(g.players) == 1 {
 		winner := g.players[0]
-		msg := g.makeNameToDisplay(winner.ID, winner.Name) + WINNERMSG
-		g.gameover = true
+		msg := g.playerMessage(WINNERMSG, winner)
+		g.transition(PhaseEventFinish)
 		g.message = msg
 		return true, msg
 	}
 
-	return false, ""
+	return false, i18n.Message{}
 }
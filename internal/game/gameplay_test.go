@@ -1,11 +1,11 @@
 package game
 
 import (
-	"fmt"
 	"github.com/stretchr/testify/assert"
-	"runtime"
 	"testing"
+	"time"
 	"unicode/utf8"
+	"wordgame/internal/i18n"
 )
 
 func TestWordDBCheck(t *testing.T) {
@@ -29,13 +29,16 @@ func TestMakeStartWord(t *testing.T) {
 }
 
 func TestNewRound(t *testing.T) {
-	g := SetupDefaultPlayers()
+	g := SetupStartedGame()
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.usedWords["주사"] = true
+	g.transition(PhaseEventPlayerOut)
 
 	g.startNewRound()
 
 	assert.Equal(t, 1, len(g.usedWords), "Used words should be reset for new round") //시작 단어는 들어가 있어야하니.
-	assert.True(t, g.started, "Game should be marked as started")
-	assert.False(t, g.gameover, "Game should not be marked as over")
+	assert.Equal(t, PhaseInTurn, g.state.Phase(), "New round should open the first turn")
 	assert.NotEmpty(t, g.currentUserID, "Current user ID should be set to a player")
 	assert.Equal(t, g.startword, g.lastWord, "Last word should be set to start word")
 	assert.Contains(t, g.usedWords, g.startword, "Start word should be in used words")
@@ -46,9 +49,10 @@ func TestStartGame(t *testing.T) {
 
 	host := g.players[0]
 
-	g.startGame(host)
+	startReadyGame(g, host)
 
-	assert.True(t, g.started, "Game should be marked as started")
+	assert.True(t, g.isStarted(), "Game should be marked as started")
+	assert.Equal(t, PhaseInTurn, g.state.Phase(), "Game should be waiting for the first word")
 }
 
 func TestStartGameNotStartedAgain(t *testing.T) {
@@ -56,10 +60,10 @@ func TestStartGameNotStartedAgain(t *testing.T) {
 
 	host := g.players[0]
 
-	g.startGame(host)
+	startReadyGame(g, host)
 	g.startGame(host)
 
-	assert.Equal(t, g.message, GAMEALREADYSTARTEDMSG, "Message should indicate game has already started")
+	assert.Equal(t, g.message.Code, GAMEALREADYSTARTEDMSG, "Message should indicate game has already started")
 }
 
 func TestStartGameNotHost(t *testing.T) {
@@ -69,7 +73,7 @@ func TestStartGameNotHost(t *testing.T) {
 
 	g.startGame(nonHost)
 
-	assert.Equal(t, g.message, NOHOSTPRIVILEGESMSG, "Message should indicate lack of host privileges")
+	assert.Equal(t, g.message.Code, NOHOSTPRIVILEGESMSG, "Message should indicate lack of host privileges")
 }
 
 func TestStartGameMinPlayerNotEntered(t *testing.T) {
@@ -80,7 +84,7 @@ func TestStartGameMinPlayerNotEntered(t *testing.T) {
 	g.addUser(host)
 	g.startGame(host)
 
-	expectedMsg := fmt.Sprintf(MINPLAYERTOSTARTMSG, MinPlayersToStart)
+	expectedMsg := i18n.New(MINPLAYERTOSTARTMSG, "count", MinPlayersToStart)
 	assert.Equal(t, g.message, expectedMsg, "Message should indicate not enough players to start")
 }
 
@@ -90,11 +94,10 @@ func TestReset(t *testing.T) {
 
 	host := g.players[0]
 
-	g.startGame(host)
+	startReadyGame(g, host)
 	g.reset()
 
-	assert.False(t, g.started, "Game should be marked as not started")
-	assert.False(t, g.gameover, "Game should be marked as not over")
+	assert.Equal(t, PhaseLobby, g.state.Phase(), "Game should be back in the lobby")
 	assert.Empty(t, g.currentUserID, "Current user ID should be cleared")
 	assert.Empty(t, g.startword, "Start word should be cleared")
 	assert.Empty(t, g.lastWord, "Last word should be cleared")
@@ -106,14 +109,18 @@ func TestEndGame(t *testing.T) {
 
 	host := g.players[0]
 
-	g.startGame(host)
-	beforeGoroutines := runtime.NumGoroutine()
-	g.endGame("test game over")
+	startReadyGame(g, host)
+	g.endGame(i18n.New(ADMINENDMSG))
+
+	assert.Equal(t, PhaseGameOver, g.state.Phase(), "Game should be marked as over")
+	assert.Equal(t, g.message.Code, ADMINENDMSG, "Message should be set to game over message")
+	assert.Equal(t, 1, FakeClock(g).Pending(), "Resetting the game should be scheduled")
+
+	FakeClock(g).Advance(GameResetDelay - time.Second)
+	assert.True(t, g.isGameOver(), "Game should stay over until the reset delay passes")
 
-	assert.True(t, g.gameover, "Game should be marked as over")
-	assert.Equal(t, g.message, "test game over", "Message should be set to game over message")
-	afterGoroutines := runtime.NumGoroutine()
-	assert.Equal(t, 1, afterGoroutines-beforeGoroutines, "There should be one additional goroutine for resetting the game")
+	FakeClock(g).Advance(time.Second)
+	assert.Equal(t, PhaseLobby, g.state.Phase(), "Game should be back in the lobby after the reset delay")
 }
 
 func TestEliminatePlayer(t *testing.T) {
@@ -121,9 +128,9 @@ func TestEliminatePlayer(t *testing.T) {
 
 	host := g.players[0]
 
-	g.startGame(host)
+	startReadyGame(g, host)
 	playerToEliminate := g.players[1]
-	g.eliminatePlayer(playerToEliminate, "test elimination")
+	g.eliminatePlayer(playerToEliminate, i18n.New(WORDNOTINDICTMSG))
 
 	assert.Equal(t, 2, len(g.players), "There should be 2 players left after elimination")
 	assert.Equal(t, 1, len(g.spectators), "There should be 1 spectator after elimination")
@@ -137,48 +144,48 @@ func TestHandlePlayIfGameNotStarted(t *testing.T) {
 	g.currentUserID = host.ID
 	g.handlePlay(host, "사과")
 
-	assert.Equal(t, g.message, NOTTOHANDLEPLAYMSG, "Message should indicate game has not started")
+	assert.Equal(t, g.message.Code, NOTTOHANDLEPLAYMSG, "Message should indicate game has not started")
 }
 
 func TestHandlePlayIfNotCurrentUserEntered(t *testing.T) {
 	g := SetupDefaultPlayers()
 
 	host := g.players[0]
-	g.startGame(host)
+	startReadyGame(g, host)
 	g.currentUserID = host.ID
 	nonCurrentPlayer := g.players[1]
 	g.handlePlay(nonCurrentPlayer, "사과")
 
-	assert.Equal(t, g.message, NOTCURRENTPLAYERSMSG, "Message should indicate not current user's turn")
+	assert.Equal(t, g.message.Code, NOTCURRENTPLAYERSMSG, "Message should indicate not current user's turn")
 }
 
 func TestHandlePlayIfWordNotEntered(t *testing.T) {
 	g := SetupDefaultPlayers()
 
 	host := g.players[0]
-	g.startGame(host)
+	startReadyGame(g, host)
 	g.currentUserID = host.ID
 	g.handlePlay(host, "")
 
-	assert.Equal(t, g.message, TYPEWORDMSG, "Message should prompt to enter a word")
+	assert.Equal(t, g.message.Code, TYPEWORDMSG, "Message should prompt to enter a word")
 }
 
 func TestHandlePlayIfWordTooShort(t *testing.T) {
 	g := SetupDefaultPlayers()
 
 	host := g.players[0]
-	g.startGame(host)
+	startReadyGame(g, host)
 	g.currentUserID = host.ID
 	g.handlePlay(host, "사")
 
-	assert.Equal(t, g.message, MINWORDLENGTHMSG, "Message should indicate word is too short")
+	assert.Equal(t, g.message.Code, MINWORDLENGTHMSG, "Message should indicate word is too short")
 }
 
 func TestHandlePlayIfWordIsNotMatchRule(t *testing.T) {
 	g := SetupDefaultPlayers()
 
 	host := g.players[0]
-	g.startGame(host)
+	startReadyGame(g, host)
 	g.currentUserID = host.ID
 	g.lastWord = "사과"
 	g.handlePlay(host, "바나나")
@@ -190,7 +197,7 @@ func TestHandlePlayIfWordIsNotInDict(t *testing.T) {
 	g := SetupDefaultPlayers()
 
 	host := g.players[0]
-	g.startGame(host)
+	startReadyGame(g, host)
 	g.currentUserID = host.ID
 	g.lastWord = "사과"
 	g.handlePlay(host, "과과과과")
@@ -202,7 +209,7 @@ func TestHandlePlayIfWordAlreadyUsed(t *testing.T) {
 	g := SetupDefaultPlayers()
 
 	host := g.players[0]
-	g.startGame(host)
+	startReadyGame(g, host)
 	g.currentUserID = host.ID
 	g.lastWord = "사과"
 	g.usedWords["과일"] = true
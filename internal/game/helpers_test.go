@@ -0,0 +1,162 @@
+package game
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"wordgame/internal/clock"
+	"wordgame/internal/random"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestSeed 는 테스트용 게임의 난수 시드다. 같은 시드면 시작 단어와 첫 차례가 늘 같다.
+const TestSeed = 1
+
+// TestStartTime 은 테스트용 게임 시계가 가리키는 처음 시각이다.
+var TestStartTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// TestWords 는 테스트용 사전이다. 길이별로 가장 앞 단어가 시작 단어가 되므로 모두 이어지는 단어가 있다.
+var TestWords = []string{
+	"사과", "과일", "일기", "기차", "차표", "표지", "지도", "도시", "시소", "소나무",
+	"무지개", "개나리", "리본", "본문", "문어", "어부", "부자", "자동차", "차고", "고구마",
+	"마차", "바나나", "계단", "단어", "어린이날", "날씨", "씨앗", "자동차경주", "주사",
+}
+
+// testGameConfig 는 테스트용 게임을 만들 때 기본값에서 바꿀 것들이다.
+type testGameConfig struct {
+	words        []string
+	settings     *RoomSettings
+	limits       *Limits
+	players      int
+	wordListMode string
+	wordList     []string
+}
+
+// TestGameOption 은 NewTestGame 과 Setup* 함수의 기본값을 바꾼다.
+type TestGameOption func(*testGameConfig)
+
+// WithWords 는 TestWords 대신 words 로 사전을 만든다.
+func WithWords(words ...string) TestGameOption {
+	return func(c *testGameConfig) { c.words = words }
+}
+
+// WithSettings 는 플레이어가 들어온 뒤 방 설정을 바꾼다.
+func WithSettings(settings RoomSettings) TestGameOption {
+	return func(c *testGameConfig) { c.settings = &settings }
+}
+
+// WithLimits 는 방을 만들기 전에 서버 제한을 바꾼다.
+func WithLimits(limits Limits) TestGameOption {
+	return func(c *testGameConfig) { c.limits = &limits }
+}
+
+// WithPlayers 는 기본 플레이어 수(3명)를 바꾼다. 최대 4명이다.
+func WithPlayers(n int) TestGameOption {
+	return func(c *testGameConfig) { c.players = n }
+}
+
+// WithWordList 는 방 전용 단어 목록을 건다.
+func WithWordList(mode string, words ...string) TestGameOption {
+	return func(c *testGameConfig) {
+		c.wordListMode, c.wordList = mode, words
+	}
+}
+
+func newTestGameConfig(opts []TestGameOption) testGameConfig {
+	cfg := testGameConfig{words: TestWords, players: 3}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// NewTestGame 은 파일이나 DB 없이 메모리 사전, 고정 시드 난수, 직접 흘리는 시계로 방을 만들어 매니저에 등록한다.
+// 타이머는 FakeClock(g).Advance 로 시간을 흘려야 실행된다. 플레이어는 넣지 않는다.
+func NewTestGame(opts ...TestGameOption) *Game {
+	cfg := newTestGameConfig(opts)
+	g := newTestGame(cfg)
+	cfg.apply(g)
+	return g
+}
+
+func newTestGame(cfg testGameConfig) *Game {
+	rnd := random.NewSeededManager(TestSeed)
+	rm := NewRoomManager(rnd, slog.Default())
+	rm.SetClock(clock.NewFake(TestStartTime))
+	if cfg.limits != nil {
+		rm.SetLimits(*cfg.limits)
+	}
+	g := NewGame("Test Room", 1, rm, rnd, NewMemoryStore(cfg.words...))
+	rm.rooms[g.RoomId] = g
+	return g
+}
+
+// apply 는 방 설정과 단어 목록을 건다. 팀 배정이 되도록 플레이어를 넣은 뒤에 부른다.
+func (cfg testGameConfig) apply(g *Game) {
+	if cfg.settings != nil {
+		g.ApplySettings(*cfg.settings)
+	}
+	if cfg.wordList != nil {
+		wl, _ := NewCustomWordList(cfg.wordListMode, cfg.wordList, g.dictionary().Normalize)
+		_ = g.SetWordList(wl)
+	}
+}
+
+// FakeClock 은 NewTestGame 으로 만든 게임의 시계다.
+func FakeClock(g *Game) *clock.Fake {
+	return g.clock.(*clock.Fake)
+}
+
+func SetupTestGame(opts ...TestGameOption) *Game {
+	return NewTestGame(opts...)
+}
+
+// defaultTestUsers 는 Setup* 함수가 넣는 플레이어를 새로 만든다. 첫 플레이어가 방장이다.
+func defaultTestUsers() []*User {
+	return []*User{
+		{ID: "1001", Name: "Alice", Tag: "7001"},
+		{ID: "1002", Name: "Bob", Tag: "7002"},
+		{ID: "1003", Name: "Charlie", Tag: "7003"},
+		{ID: "1004", Name: "Dave", Tag: "7004"},
+	}
+}
+
+// SetupDefaultPlayers 는 로비에 기본 플레이어를 넣은 게임을 만든다.
+func SetupDefaultPlayers(opts ...TestGameOption) *Game {
+	cfg := newTestGameConfig(opts)
+	g := newTestGame(cfg)
+	g.mu.Lock()
+	g.players = defaultTestUsers()[:cfg.players:cfg.players]
+	g.hostUserId = g.players[0].ID
+	g.mu.Unlock()
+	cfg.apply(g)
+	return g
+}
+
+// SetupStartedGame 은 SetupDefaultPlayers 의 게임을 방장이 시작하고 카운트다운이 끝난 뒤로 돌려준다.
+// 시드가 고정이라 기본 설정과 TestWords 로는 늘 1003 의 차례에 "자동차경주"로 시작한다.
+func SetupStartedGame(opts ...TestGameOption) *Game {
+	g := SetupDefaultPlayers(opts...)
+	startReadyGame(g, g.players[0])
+	return g
+}
+
+// currentPlayer 는 지금 차례인 플레이어다.
+func currentPlayer(g *Game) *User {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.findUser(g.currentUserID)
+}
+
+// playTurns 는 차례가 돌아가는 대로 words 를 하나씩 내고, 모두 받아들여졌는지 확인한다.
+func playTurns(t *testing.T, g *Game, words ...string) {
+	for _, word := range words {
+		g.handlePlay(currentPlayer(g), word)
+		g.mu.Lock()
+		last := g.lastWord
+		g.mu.Unlock()
+		require.Equal(t, word, last, "%q should be accepted", word)
+	}
+}
@@ -0,0 +1,121 @@
+package game
+
+import (
+	"errors"
+	"unicode/utf8"
+
+	"wordgame/internal/i18n"
+	"wordgame/internal/logging"
+)
+
+var (
+	ErrHintsDisabled     = errors.New("hints are disabled")
+	ErrNotYourTurn       = errors.New("not your turn")
+	ErrNoHint            = errors.New("no word can follow")
+	ErrHintNotAffordable = errors.New("not enough lives for a hint")
+)
+
+// HintInfo 는 힌트를 켠 방의 상태 브로드캐스트에 들어가는 정보다.
+type HintInfo struct {
+	Continuations int `json:"continuations"` // 지금 이어 낼 수 있는, 아직 쓰지 않은 단어 수
+}
+
+// Hint 는 힌트를 요청한 플레이어에게만 보내는 단어의 앞부분과 길이다.
+type Hint struct {
+	Prefix string `json:"prefix"`
+	Length int    `json:"length"`
+}
+
+// RequestHint 는 차례인 플레이어가 대가를 치르고 이어 낼 수 있는 단어 하나의 앞부분을 받는다.
+// 점수 모드는 점수를, 팀 모드는 팀 목숨을, 그 밖에는 플레이어 목숨을 하나 쓴다.
+func (g *Game) RequestHint(userID string) (Hint, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if !g.settings.Hints {
+		return Hint{}, ErrHintsDisabled
+	}
+	if !g.isTurnOpen() || g.currentUserID != userID {
+		return Hint{}, ErrNotYourTurn
+	}
+	user := g.findUser(userID)
+	if user == nil {
+		return Hint{}, ErrUserNotFound
+	}
+	candidates := g.nextWordCandidates()
+	if len(candidates) == 0 {
+		return Hint{}, ErrNoHint
+	}
+	if err := g.payHintCost(user); err != nil {
+		return Hint{}, err
+	}
+
+	word := candidates[g.random.MakeRandomNumber(0, len(candidates))]
+	g.message = g.playerMessage(HINTUSEDMSG, user)
+	logging.Info(g.logger, HINTLOGMSG, logging.UserIDKey, user.ID)
+	return makeHint(word), nil
+}
+
+func (g *Game) handleRequestHint(user *User) {
+	hint, err := g.RequestHint(user.ID)
+	if err != nil {
+		g.sendNotice(user, HINTERRORCODE, i18n.New(hintErrorMessage(err)))
+		return
+	}
+	g.sendNotice(user, HINTCODE, i18n.New(HINTMSG, "prefix", hint.Prefix, "length", hint.Length))
+	g.broadcastGameState()
+}
+
+func hintErrorMessage(err error) string {
+	switch {
+	case errors.Is(err, ErrHintsDisabled):
+		return HINTDISABLEDMSG
+	case errors.Is(err, ErrNoHint):
+		return NOHINTMSG
+	case errors.Is(err, ErrHintNotAffordable):
+		return HINTNOTAFFORDABLEMSG
+	default:
+		return NOTCURRENTPLAYERSMSG
+	}
+}
+
+// 아래 함수들은 모두 잠금을 호출자가 관리한다.
+
+// payHintCost 는 힌트 값을 치른다. 힌트 때문에 탈락하지는 않도록 마지막 목숨은 쓰지 않는다.
+func (g *Game) payHintCost(user *User) error {
+	switch {
+	case g.settings.ScoreMode:
+		entry := g.scoreOf(user)
+		entry.PenaltyPoints += HintCostPoints
+		entry.Score -= HintCostPoints
+	case g.settings.TeamMode:
+		team, ok := g.teams[user.ID]
+		if !ok || team >= len(g.teamLives) || g.teamLives[team] <= 1 {
+			return ErrHintNotAffordable
+		}
+		g.teamLives[team]--
+	default:
+		if g.lives[user.ID] <= 1 {
+			return ErrHintNotAffordable
+		}
+		g.lives[user.ID]--
+	}
+	return nil
+}
+
+// makeHint 는 단어의 앞 두 음절을 보여준다. 두 음절 이하인 단어는 마지막 음절을 숨긴다.
+func makeHint(word string) Hint {
+	length := utf8.RuneCountInString(word)
+	n := HintPrefixRunes
+	if n >= length {
+		n = length - 1
+	}
+	return Hint{Prefix: firstRunes(word, n), Length: length}
+}
+
+func (g *Game) makeHintInfo() *HintInfo {
+	if !g.settings.Hints || !g.isTurnOpen() {
+		return nil
+	}
+	return &HintInfo{Continuations: len(g.nextWordCandidates())}
+}
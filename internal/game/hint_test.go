@@ -0,0 +1,77 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// hintWords 는 TestWords 에 "주" 단어 둘과, 다시 "주"로 이어지는 "사주"를 더한 사전이다. 시작 단어 "자동차경주" 다음에 세 단어가 이어진다.
+var hintWords = append([]string{"주사위", "주머니", "사주"}, TestWords...)
+
+func newHintSettings(lives int) RoomSettings {
+	settings := DefaultRoomSettings()
+	settings.Hints = true
+	settings.PlayerLives = lives
+	return settings
+}
+
+func TestHintInfo(t *testing.T) {
+	g := SetupStartedGame(WithSettings(newHintSettings(2)), WithWords(hintWords...))
+	assert.Equal(t, 3, g.makeHintInfo().Continuations)
+
+	playTurns(t, g, "주사", "사주")
+	assert.Equal(t, 2, g.makeHintInfo().Continuations, "Used words should not be counted")
+
+	g.settings.Hints = false
+	assert.Nil(t, g.makeHintInfo(), "Rooms without hints should not show continuations")
+}
+
+func TestRequestHintCostsLife(t *testing.T) {
+	g := SetupStartedGame(WithSettings(newHintSettings(2)), WithWords(hintWords...))
+	player := currentPlayer(g)
+
+	hint, err := g.RequestHint(player.ID)
+
+	assert.NoError(t, err)
+	assert.Contains(t, []string{"주", "주사", "주머"}, hint.Prefix, "Hint should reveal the start of an unused word")
+	assert.Equal(t, 1, g.lives[player.ID], "Hint should cost a life")
+
+	_, err = g.RequestHint(player.ID)
+	assert.ErrorIs(t, err, ErrHintNotAffordable, "Hint should never cost the last life")
+	for _, p := range g.players {
+		if p != player {
+			_, err = g.RequestHint(p.ID)
+			assert.ErrorIs(t, err, ErrNotYourTurn)
+		}
+	}
+}
+
+func TestRequestHintWithDefaultSettings(t *testing.T) {
+	settings := DefaultRoomSettings()
+	settings.Hints = true
+	g := SetupStartedGame(WithSettings(settings), WithWords(hintWords...))
+	player := currentPlayer(g)
+
+	_, err := g.RequestHint(player.ID)
+
+	assert.NoError(t, err, "A default room with hints on should be able to pay for a hint")
+	assert.Equal(t, MinHintLives-1, g.lives[player.ID], "Hint should cost one of the lives hints grant")
+}
+
+func TestRequestHintCostsPoints(t *testing.T) {
+	settings := newHintSettings(1)
+	settings.ScoreMode = true
+	g := SetupStartedGame(WithSettings(settings), WithWords(hintWords...))
+	player := currentPlayer(g)
+
+	_, err := g.RequestHint(player.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, -HintCostPoints, g.scores[player.ID].Score, "Hint should cost points in score mode")
+}
+
+func TestMakeHint(t *testing.T) {
+	assert.Equal(t, Hint{Prefix: "과수", Length: 3}, makeHint("과수원"))
+	assert.Equal(t, Hint{Prefix: "과", Length: 2}, makeHint("과일"), "Two-syllable words should keep the last syllable hidden")
+}
@@ -1,67 +1,107 @@
 package game
 
 import (
-	"log"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"strconv"
 )
 
-func (g *Game) generateUniqueID() string {
-	const maxAttempts = MAXIDENTIFIER - MINIDENTIFIER + 1
-	for i := 0; i < maxAttempts; i++ {
-		id := g.makeRandomID()
-		exists := g.isIDExist(id)
-		if !exists {
-			return id
+var ErrNoFreeTag = errors.New("no free display tag in room")
+
+// generateUserID 는 추측할 수 없는 세션 ID를 만든다. 화면에 보이는 태그와는 별개다.
+func generateUserID() (string, error) {
+	b := make([]byte, USERIDBYTES)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// assignTag 는 이름 뒤에 붙는 4자리 태그를 방 안에서 겹치지 않게 고른다. (잠금은 호출자가 관리)
+func (g *Game) assignTag(user *User) error {
+	for i := 0; i < MaxTagAttempts; i++ {
+		tag := g.makeRandomTag()
+		if !g.isTagExist(tag) {
+			user.Tag = tag
+			return nil
+		}
+	}
+
+	// 무작위로 찾지 못하면 빈 태그를 차례대로 찾는다.
+	for n := MINIDENTIFIER; n <= MAXIDENTIFIER; n++ {
+		tag := strconv.Itoa(n)
+		if !g.isTagExist(tag) {
+			user.Tag = tag
+			return nil
 		}
 	}
-	log.Println(IDMAXATTEMPTSLOGMSG)
-	return IDFALLBACK
+	return ErrNoFreeTag
 }
 
 func (g *Game) selectRandomPlayerIndex() int {
 	return g.random.MakeRandomNumber(0, len(g.players))
 }
 
-func (g *Game) makeNameToDisplay(userID, userName string) string {
-	return userName + IDSUFFIX + userID
+func (g *Game) makeNameToDisplay(tag, userName string) string {
+	return userName + IDSUFFIX + tag
 }
 
-func (g *Game) makeRandomID() string {
+func (g *Game) makeRandomTag() string {
 	n := g.random.MakeRandomNumber(MINIDENTIFIER, MAXIDENTIFIER+1)
 	return strconv.Itoa(n)
 }
 
-func (g *Game) isIDExist(id string) bool {
-	g.mu.Lock()
-	defer g.mu.Unlock()
+// findUserByTag 는 공개 ID(태그)로 방 안의 플레이어나 관전자를 찾는다.
+func (g *Game) findUserByTag(tag string) *User {
+	if tag == "" {
+		return nil
+	}
+	for _, p := range g.players {
+		if p.Tag == tag {
+			return p
+		}
+	}
+	for _, s := range g.spectators {
+		if s.Tag == tag {
+			return s
+		}
+	}
+	return nil
+}
+
+// publicID 는 세션 ID 대신 다른 접속자에게 보여줄 공개 ID(태그)를 돌려준다. 없는 사용자면 빈 문자열이다.
+func (g *Game) publicID(userID string) string {
+	if user := g.findUser(userID); user != nil {
+		return user.Tag
+	}
+	return ""
+}
 
-	if g.isIDInPlayers(id) {
+func (g *Game) isTagExist(tag string) bool {
+	if g.isTagInPlayers(tag) {
 		return true
 	}
-	if g.isIDInSpectators(id) {
+	if g.isTagInSpectators(tag) {
 		return true
 	}
 	return false
 }
 
-func (g *Game) isIDInPlayers(id string) bool {
+func (g *Game) isTagInPlayers(tag string) bool {
 	for _, p := range g.players {
-		if g.isIDEqual(p.ID, id) {
+		if p.Tag == tag {
 			return true
 		}
 	}
 	return false
 }
 
-func (g *Game) isIDInSpectators(id string) bool {
+func (g *Game) isTagInSpectators(tag string) bool {
 	for _, s := range g.spectators {
-		if g.isIDEqual(s.ID, id) {
+		if s.Tag == tag {
 			return true
 		}
 	}
 	return false
 }
-
-func (g *Game) isIDEqual(id1, id2 string) bool {
-	return id1 == id2
-}
@@ -7,24 +7,43 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestGenerateUniqueID(t *testing.T) {
+func TestGenerateUserID(t *testing.T) {
+	id1, err1 := generateUserID()
+	id2, err2 := generateUserID()
 
+	assert.NoError(t, err1, "ID1 should be generated without error")
+	assert.NoError(t, err2, "ID2 should be generated without error")
+	assert.Len(t, id1, USERIDBYTES*2, "Generated ID1 should be a hex string of USERIDBYTES bytes")
+	assert.NotEqual(t, id1, id2, "Generated IDs should be unique")
+}
+
+func TestAssignTag(t *testing.T) {
 	g := SetupTestGame()
+	user1 := &User{ID: "a", Name: "Alice"}
+	user2 := &User{ID: "b", Name: "Bob"}
 
-	id1 := g.generateUniqueID()
-	id2 := g.generateUniqueID()
+	assert.NoError(t, g.addUser(user1))
+	assert.NoError(t, g.addUser(user2))
 
-	id1ToInt, err1 := strconv.Atoi(id1)
-	id2ToInt, err2 := strconv.Atoi(id2)
+	tag1, err1 := strconv.Atoi(user1.Tag)
+	tag2, err2 := strconv.Atoi(user2.Tag)
 
-	assert.NoError(t, err1, "ID1 should be convertible to integer")
-	assert.NoError(t, err2, "ID2 should be convertible to integer")
-	assert.Greater(t, id1ToInt, 999, "Generated ID1 should be at least 1000")
-	assert.Greater(t, id2ToInt, 999, "Generated ID2 should be at least 1000")
-	assert.Less(t, id1ToInt, 10000, "Generated ID1 should be at most 9999")
-	assert.Less(t, id2ToInt, 10000, "Generated ID2 should be at most 9999")
-	assert.NotEqual(t, id1, id2, "Generated IDs should be unique")
+	assert.NoError(t, err1, "Tag1 should be convertible to integer")
+	assert.NoError(t, err2, "Tag2 should be convertible to integer")
+	assert.GreaterOrEqual(t, tag1, MINIDENTIFIER, "Tag1 should be at least MINIDENTIFIER")
+	assert.LessOrEqual(t, tag2, MAXIDENTIFIER, "Tag2 should be at most MAXIDENTIFIER")
+	assert.NotEqual(t, user1.Tag, user2.Tag, "Tags should be unique in a room")
+}
+
+func TestAssignTagExhausted(t *testing.T) {
+	g := SetupTestGame()
+	for n := MINIDENTIFIER; n <= MAXIDENTIFIER; n++ {
+		g.spectators = append(g.spectators, &User{ID: strconv.Itoa(n), Tag: strconv.Itoa(n)})
+	}
+
+	err := g.assignTag(&User{ID: "late", Name: "Late"})
 
+	assert.ErrorIs(t, err, ErrNoFreeTag, "Assigning a tag should fail when every tag is taken")
 }
 
 func TestSelectRandomPlayerIndex(t *testing.T) {
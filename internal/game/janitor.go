@@ -0,0 +1,106 @@
+package game
+
+import (
+	"time"
+
+	"wordgame/internal/i18n"
+	"wordgame/internal/logging"
+)
+
+// JanitorConfig 는 오래 쓰이지 않은 방을 정리하는 기준이다. 0인 항목은 검사하지 않는다.
+type JanitorConfig struct {
+	Interval        time.Duration // 검사 주기
+	UnusedRoomTTL   time.Duration // 만들어진 뒤 아무도 들어오지 않은 방
+	IdleRoomTTL     time.Duration // 아무 활동이 없는 방
+	FinishedRoomTTL time.Duration // 게임이 끝난 뒤 새 게임이 시작되지 않은 방
+}
+
+func DefaultJanitorConfig() JanitorConfig {
+	return JanitorConfig{
+		Interval:        DefaultJanitorInterval,
+		UnusedRoomTTL:   DefaultUnusedRoomTTL,
+		IdleRoomTTL:     DefaultIdleRoomTTL,
+		FinishedRoomTTL: DefaultFinishedRoomTTL,
+	}
+}
+
+// StartJanitor 는 주기적으로 방을 정리하는 고루틴을 시작한다. 이미 실행 중이면 다시 시작한다.
+func (rm *RoomManager) StartJanitor(cfg JanitorConfig) {
+	if cfg.Interval <= 0 {
+		return
+	}
+	rm.StopJanitor()
+
+	stop := make(chan struct{})
+	rm.mutex.Lock()
+	rm.janitorStop = stop
+	rm.mutex.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(cfg.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				rm.reapRooms(cfg, rm.clock.Now())
+			}
+		}
+	}()
+}
+
+func (rm *RoomManager) StopJanitor() {
+	rm.mutex.Lock()
+	defer rm.mutex.Unlock()
+
+	if rm.janitorStop != nil {
+		close(rm.janitorStop)
+		rm.janitorStop = nil
+	}
+}
+
+// reapRooms 는 기준에 걸린 방을 닫고, 닫은 방 번호를 돌려준다.
+func (rm *RoomManager) reapRooms(cfg JanitorConfig, now time.Time) []int {
+	reaped := make([]int, 0)
+	for _, game := range rm.snapshotRooms() {
+		reason, message := game.reapReason(cfg, now)
+		if reason == "" {
+			continue
+		}
+		if rm.CloseRoom(game.RoomId, message) {
+			logging.Info(game.logger, REAPROOMLOGMSG, "reason", reason)
+			reaped = append(reaped, game.RoomId)
+		}
+	}
+	return reaped
+}
+
+// reapReason 은 방을 정리해야 하면 그 이유와 접속자에게 보낼 메시지를 돌려준다.
+func (g *Game) reapReason(cfg JanitorConfig, now time.Time) (string, i18n.Message) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	idle := now.Sub(g.lastActivity)
+	switch {
+	case !g.connected:
+		if isExpired(now.Sub(g.createdAt), cfg.UnusedRoomTTL) {
+			return "unused", i18n.New(IDLEROOMCLOSEDMSG)
+		}
+	case !g.isStarted() && !g.finishedAt.IsZero() && isExpired(idle, cfg.FinishedRoomTTL):
+		return "finished", i18n.New(IDLEROOMCLOSEDMSG)
+	case isExpired(idle, cfg.IdleRoomTTL):
+		return "idle", i18n.New(IDLEROOMCLOSEDMSG)
+	}
+	return "", i18n.Message{}
+}
+
+func (g *Game) touch() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.lastActivity = g.clock.Now()
+}
+
+func isExpired(elapsed, ttl time.Duration) bool {
+	return ttl > 0 && elapsed >= ttl
+}
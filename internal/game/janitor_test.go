@@ -0,0 +1,73 @@
+package game
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"wordgame/internal/i18n"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReapUnusedRoom(t *testing.T) {
+	g := SetupTestGame()
+	rm := g.manager
+	cfg := JanitorConfig{UnusedRoomTTL: time.Minute}
+
+	assert.Empty(t, rm.reapRooms(cfg, g.createdAt.Add(30*time.Second)), "Fresh room should be kept")
+
+	reaped := rm.reapRooms(cfg, g.createdAt.Add(time.Minute))
+	assert.Equal(t, []int{g.RoomId}, reaped, "Room nobody joined should be reaped")
+	_, exists := rm.GetRoom(g.RoomId)
+	assert.False(t, exists, "Reaped room should be removed from the manager")
+}
+
+func TestReapIdleRoom(t *testing.T) {
+	g := SetupTestGame()
+	rm := g.manager
+	g.connected = true
+	cfg := JanitorConfig{UnusedRoomTTL: time.Minute, IdleRoomTTL: time.Hour}
+
+	assert.Empty(t, rm.reapRooms(cfg, g.lastActivity.Add(time.Minute)), "Joined room should not be treated as unused")
+	assert.Len(t, rm.reapRooms(cfg, g.lastActivity.Add(time.Hour)), 1, "Idle room should be reaped")
+}
+
+func TestReapFinishedRoom(t *testing.T) {
+	g := SetupTestGame()
+	rm := g.manager
+	g.connected = true
+	g.finishedAt = g.lastActivity
+	cfg := JanitorConfig{IdleRoomTTL: time.Hour, FinishedRoomTTL: 10 * time.Minute}
+
+	assert.Len(t, rm.reapRooms(cfg, g.lastActivity.Add(10*time.Minute)), 1, "Finished room without a new game should be reaped")
+}
+
+func TestReapRoomInProgress(t *testing.T) {
+	g := SetupStartedGame()
+	rm := g.manager
+	g.connected = true
+	g.finishedAt = g.lastActivity
+	cfg := JanitorConfig{IdleRoomTTL: time.Hour, FinishedRoomTTL: 10 * time.Minute}
+
+	assert.Empty(t, rm.reapRooms(cfg, g.lastActivity.Add(10*time.Minute)), "Running game should only be reaped after the idle TTL")
+}
+
+func TestRoomStopUnblocksSenders(t *testing.T) {
+	room := NewRoom(slog.Default())
+	go room.Run()
+	room.Stop()
+
+	done := make(chan struct{})
+	go func() {
+		room.Broadcast(LocalizedMessage{i18n.DefaultLocale: []byte("{}")})
+		room.Unregister(&User{ID: "1"})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Senders should not block after the room is stopped")
+	}
+}
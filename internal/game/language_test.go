@@ -0,0 +1,107 @@
+package game
+
+import (
+	"testing"
+
+	"wordgame/internal/i18n"
+	"wordgame/internal/store"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLanguageNormalized(t *testing.T) {
+	assert.Equal(t, store.DefaultLanguage, RoomSettings{Language: "xx"}.normalized().Language, "Unknown language should fall back to the default")
+	assert.Equal(t, store.LanguageEnglish, RoomSettings{Language: store.LanguageEnglish}.normalized().Language)
+}
+
+func TestEnglishMinWordLength(t *testing.T) {
+	g := SetupTestGame()
+	settings := DefaultRoomSettings()
+	settings.Language = store.LanguageEnglish
+	g.ApplySettings(settings)
+
+	g.mu.Lock()
+	rejected := g.handleWordIsNotEnoughLength("ox")
+
+	assert.True(t, rejected, "English words need at least three letters")
+	assert.Equal(t, i18n.New(MINWORDLENGTHMSG, "length", 3), g.message)
+}
+
+func TestEnglishRoomSkipsSuggestions(t *testing.T) {
+	g := SetupTestGame()
+	settings := DefaultRoomSettings()
+	settings.Language = store.LanguageEnglish
+	g.ApplySettings(settings)
+	user := &User{ID: "1001", Name: "Alice"}
+
+	g.rememberRejectedWord(user, "qwerty")
+
+	assert.NotContains(t, g.rejectedWords, user.ID, "Suggestions only apply to the default dictionary")
+}
+
+func TestGetRoomsLanguage(t *testing.T) {
+	g := SetupTestGame()
+	g.ApplySettings(RoomSettings{Language: store.LanguageEnglish})
+	g.manager.rooms[g.RoomId] = g
+
+	rooms := g.manager.GetRooms()
+
+	assert.Equal(t, store.LanguageEnglish, rooms[0]["language"], "Room list should show the room language")
+}
+
+func TestStartWordFallsBackPerLanguage(t *testing.T) {
+	testCases := []struct {
+		language string
+		expected string
+	}{
+		{language: store.LanguageKorean, expected: "사과"},
+		{language: store.LanguageEnglish, expected: "apple"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.language, func(t *testing.T) {
+			g := NewTestGame()
+			// 시작 단어 길이에 맞는 단어가 없어 사전에서 뽑지 못하게 한다.
+			g.store.(*MemoryStore).SetWords(tc.language, "ox", "가")
+			settings := DefaultRoomSettings()
+			settings.Language = tc.language
+			g.ApplySettings(settings)
+
+			g.mu.Lock()
+			defer g.mu.Unlock()
+			assert.Equal(t, tc.expected, g.makeStartWord())
+		})
+	}
+}
+
+func TestEmptyDictionaryBlocksStart(t *testing.T) {
+	g := SetupDefaultPlayers()
+	settings := DefaultRoomSettings()
+	settings.Language = store.LanguageEnglish
+	g.ApplySettings(settings)
+	readyAll(g)
+
+	g.startGame(g.players[0])
+
+	assert.Equal(t, PhaseLobby, g.state.Phase(), "A room without dictionary words cannot be played")
+	assert.Equal(t, i18n.New(DICTIONARYEMPTYMSG, "language", store.LanguageEnglish), g.message)
+
+	wl, _ := NewCustomWordList(store.WordListModeAllow, []string{"apple", "egg"}, g.dictionary().Normalize)
+	assert.NoError(t, g.SetWordList(wl))
+	g.startGame(g.players[0])
+
+	assert.Equal(t, PhaseCountdown, g.state.Phase(), "An allow list is enough to play")
+}
+
+func TestEnglishDeadEndIgnoresShortWords(t *testing.T) {
+	g := NewTestGame()
+	g.store.(*MemoryStore).SetWords(store.LanguageEnglish, "cat", "tea", "at")
+	settings := DefaultRoomSettings()
+	settings.Language = store.LanguageEnglish
+	g.ApplySettings(settings)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	assert.False(t, g.isDeadEndWord("cat"), "\"tea\" can follow \"cat\"")
+	assert.True(t, g.isDeadEndWord("tea"), "\"at\" is too short to play")
+}
@@ -0,0 +1,94 @@
+package game
+
+import "errors"
+
+var (
+	ErrRoomFull     = errors.New("room is full")
+	ErrTooManyRooms = errors.New("too many rooms")
+	ErrServerFull   = errors.New("server is full")
+)
+
+// Limits 는 방과 서버 전체의 수용 한도다. 0이면 제한하지 않는다.
+type Limits struct {
+	MaxPlayersPerRoom    int
+	MaxSpectatorsPerRoom int
+	MaxRooms             int
+	MaxConnections       int
+	MaxConnectionsPerIP  int
+
+	// 연결 하나가 보낼 수 있는 메시지 속도. MessagesPerSecond 가 0이면 제한하지 않는다.
+	MessagesPerSecond    float64
+	MessageBurst         int
+	MaxMessageViolations int
+}
+
+func DefaultLimits() Limits {
+	return Limits{
+		MaxPlayersPerRoom:    DefaultMaxPlayersPerRoom,
+		MaxSpectatorsPerRoom: DefaultMaxSpectatorsPerRoom,
+		MaxRooms:             DefaultMaxRooms,
+		MaxConnections:       DefaultMaxConnections,
+		MaxConnectionsPerIP:  DefaultMaxConnectionsPerIP,
+		MessagesPerSecond:    DefaultMessagesPerSecond,
+		MessageBurst:         DefaultMessageBurst,
+		MaxMessageViolations: DefaultMaxMessageViolations,
+	}
+}
+
+func (rm *RoomManager) SetLimits(limits Limits) {
+	rm.mutex.Lock()
+	defer rm.mutex.Unlock()
+	rm.limits = limits
+}
+
+func (rm *RoomManager) Limits() Limits {
+	rm.mutex.RLock()
+	defer rm.mutex.RUnlock()
+	return rm.limits
+}
+
+// AcquireConnection 은 서버 전체 접속 수 한도 안에서 접속 하나를 차지한다.
+func (rm *RoomManager) AcquireConnection() error {
+	rm.mutex.Lock()
+	defer rm.mutex.Unlock()
+
+	if isLimitReached(rm.connections, rm.limits.MaxConnections) {
+		return ErrServerFull
+	}
+	rm.connections++
+	return nil
+}
+
+func (rm *RoomManager) ReleaseConnection() {
+	rm.mutex.Lock()
+	defer rm.mutex.Unlock()
+
+	if rm.connections > 0 {
+		rm.connections--
+	}
+}
+
+func (g *Game) isPlayerSlotFull() bool {
+	return isLimitReached(len(g.players), g.limits.MaxPlayersPerRoom)
+}
+
+func (g *Game) isSpectatorSlotFull() bool {
+	return isLimitReached(len(g.spectators), g.limits.MaxSpectatorsPerRoom)
+}
+
+// promoteSpectators 는 게임이 끝난 뒤 관전자를 빈 플레이어 자리로 옮긴다. (잠금은 호출자가 관리)
+func (g *Game) promoteSpectators() {
+	remaining := make([]*User, 0, len(g.spectators))
+	for _, s := range g.spectators {
+		if g.isPlayerSlotFull() {
+			remaining = append(remaining, s)
+			continue
+		}
+		g.players = append(g.players, s)
+	}
+	g.spectators = remaining
+}
+
+func isLimitReached(current, limit int) bool {
+	return limit > 0 && current >= limit
+}
@@ -0,0 +1,97 @@
+package game
+
+import (
+	"log/slog"
+	"testing"
+
+	"wordgame/internal/random"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddUserRoomFull(t *testing.T) {
+	g := SetupTestGame(WithLimits(Limits{MaxPlayersPerRoom: 2, MaxSpectatorsPerRoom: 1}))
+
+	assert.NoError(t, g.addUser(&User{ID: "1", Name: "Alice"}))
+	assert.NoError(t, g.addUser(&User{ID: "2", Name: "Bob"}))
+	assert.NoError(t, g.addUser(&User{ID: "3", Name: "Charlie"}))
+	err := g.addUser(&User{ID: "4", Name: "Dave"})
+
+	assert.Len(t, g.players, 2, "Players should not exceed MaxPlayersPerRoom")
+	assert.Len(t, g.spectators, 1, "Extra user should join as a spectator")
+	assert.ErrorIs(t, err, ErrRoomFull, "User should be rejected when both player and spectator slots are full")
+}
+
+func TestPromoteSpectatorsRespectsLimit(t *testing.T) {
+	g := SetupTestGame(WithLimits(Limits{MaxPlayersPerRoom: 2}))
+	g.players = []*User{{ID: "1", Name: "Alice"}}
+	g.spectators = []*User{{ID: "2", Name: "Bob"}, {ID: "3", Name: "Charlie"}}
+
+	g.promoteSpectators()
+
+	assert.Len(t, g.players, 2, "Spectators should fill only the free player slots")
+	assert.Len(t, g.spectators, 1, "Remaining spectators should keep waiting")
+}
+
+func TestMakeRoomTooManyRooms(t *testing.T) {
+	rm := NewRoomManager(random.NewManager(), slog.Default())
+	rm.SetLimits(Limits{MaxRooms: 1})
+
+	_, err1 := rm.MakeRoom("Room 1", NewMemoryStore())
+	_, err2 := rm.MakeRoom("Room 2", NewMemoryStore())
+
+	assert.NoError(t, err1, "First room should be created")
+	assert.ErrorIs(t, err2, ErrTooManyRooms, "Room over MaxRooms should be rejected")
+}
+
+func TestAcquireConnection(t *testing.T) {
+	rm := NewRoomManager(random.NewManager(), slog.Default())
+	rm.SetLimits(Limits{MaxConnections: 1})
+
+	assert.NoError(t, rm.AcquireConnection(), "First connection should be accepted")
+	assert.ErrorIs(t, rm.AcquireConnection(), ErrServerFull, "Connection over MaxConnections should be rejected")
+
+	rm.ReleaseConnection()
+	assert.NoError(t, rm.AcquireConnection(), "Connection should be accepted after a release")
+}
+
+func TestUserMessageLimit(t *testing.T) {
+	user := &User{ID: "1", Name: "Alice"}
+	user.setMessageLimit(Limits{MessagesPerSecond: 1, MessageBurst: 2, MaxMessageViolations: 2})
+
+	assert.True(t, user.allowMessage())
+	assert.True(t, user.allowMessage())
+	assert.False(t, user.allowMessage(), "Message over the burst should be limited")
+	assert.False(t, user.isAbusive(), "One violation should only warn the user")
+	assert.False(t, user.allowMessage())
+	assert.True(t, user.isAbusive(), "Repeated violations should disconnect the user")
+}
+
+func TestUserMessageLimitDisabled(t *testing.T) {
+	user := &User{ID: "1", Name: "Alice"}
+	user.setMessageLimit(Limits{})
+
+	for i := 0; i < 100; i++ {
+		assert.True(t, user.allowMessage(), "Zero rate should disable the message limit")
+	}
+}
+
+func TestJoinRejection(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		code string
+	}{
+		{name: "room full", err: ErrRoomFull, code: ROOMFULLCODE},
+		{name: "no free tag", err: ErrNoFreeTag, code: JOINFAILEDCODE},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			code, message := joinRejection(tc.err)
+
+			assert.Equal(t, tc.code, code)
+			assert.Equal(t, tc.code, message.Code, "Message should match the rejection code")
+		})
+	}
+}
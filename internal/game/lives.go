@@ -0,0 +1,48 @@
+package game
+
+import (
+	"wordgame/internal/i18n"
+	"wordgame/internal/logging"
+)
+
+// 아래 함수들은 모두 잠금을 호출자가 관리한다.
+
+// resetLives 는 게임을 시작할 때 모든 플레이어의 목숨을 방 설정값으로 채운다.
+func (g *Game) resetLives() {
+	g.lives = make(map[string]int, len(g.players))
+	for _, p := range g.players {
+		g.lives[p.ID] = g.settings.PlayerLives
+	}
+}
+
+// seatMidGameJoiner 는 게임 도중 플레이어로 들어온 사람에게 목숨과 점수 자리를 채운다.
+// 카운트다운 중에 들어왔으면 launchGame 이 채우므로 건너뛴다.
+func (g *Game) seatMidGameJoiner(user *User) {
+	if !g.inGame() || g.state.Phase() == PhaseCountdown {
+		return
+	}
+	g.lives[user.ID] = g.settings.PlayerLives
+	if g.settings.ScoreMode {
+		g.scoreOf(user)
+	}
+}
+
+// loseLife 는 실수한 플레이어의 목숨을 하나 줄이고 차례를 넘긴다.
+// 마지막 목숨이었으면 false 를 돌려주고, 호출자가 탈락 처리한다.
+func (g *Game) loseLife(user *User, reason i18n.Message) bool {
+	lives, ok := g.lives[user.ID]
+	if !ok || lives <= 1 {
+		return false
+	}
+	g.lives[user.ID] = lives - 1
+	logging.Info(g.logger, LIFELOSTLOGMSG, logging.UserIDKey, user.ID, "lives", lives-1)
+
+	penalty := g.playerMessage(LIFELOSTMSG, user, "lives", lives-1, "reason", reason)
+	g.setNextPlayerTurn(user.ID)
+	g.message = i18n.Join(penalty, g.message)
+	return true
+}
+
+func (g *Game) livesOf(user *User) int {
+	return g.lives[user.ID]
+}
@@ -0,0 +1,73 @@
+package game
+
+import (
+	"testing"
+
+	"wordgame/internal/i18n"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoseLifePassesTurn(t *testing.T) {
+	settings := DefaultRoomSettings()
+	settings.PlayerLives = 2
+	g := SetupStartedGame(WithSettings(settings))
+	player := currentPlayer(g)
+
+	winner, _ := g.eliminatePlayer(player, i18n.New(WORDMISMATCHMSG))
+
+	assert.False(t, winner)
+	assert.Len(t, g.players, 3, "Player with lives left should stay in the game")
+	assert.Equal(t, 1, g.lives[player.ID], "Mistake should cost a life")
+	assert.NotEqual(t, player.ID, g.currentUserID, "Turn should pass to the next player")
+	assert.Contains(t, render(i18n.LocaleEnglish, g.message), "does not start with the last syllable", "Message should explain the mistake")
+}
+
+func TestLoseLastLifeEliminates(t *testing.T) {
+	settings := DefaultRoomSettings()
+	settings.PlayerLives = 2
+	g := SetupStartedGame(WithSettings(settings))
+	player := currentPlayer(g)
+	g.lives[player.ID] = 1
+
+	g.eliminatePlayer(player, i18n.New(WORDMISMATCHMSG))
+
+	assert.Len(t, g.players, 2, "Player without lives should be eliminated")
+	assert.Len(t, g.spectators, 1, "Eliminated player should watch the rest of the game")
+}
+
+func TestMidGameJoinerGetsLives(t *testing.T) {
+	settings := DefaultRoomSettings()
+	settings.PlayerLives = 2
+	g := SetupStartedGame(WithSettings(settings), WithPlayers(2))
+	joiner := &User{ID: "1004", Name: "Dave", Tag: "7004"}
+
+	assert.NoError(t, g.addUser(joiner))
+	g.eliminatePlayer(joiner, i18n.New(WORDMISMATCHMSG))
+
+	assert.Contains(t, g.players, joiner, "Mid-game joiner should not be eliminated by a first mistake")
+	assert.Equal(t, 1, g.lives[joiner.ID], "Mid-game joiner should start with the room's lives")
+}
+
+func TestPlayerLivesInState(t *testing.T) {
+	settings := DefaultRoomSettings()
+	settings.PlayerLives = 3
+	g := SetupStartedGame(WithSettings(settings))
+
+	players := g.makePlayerList()
+
+	assert.Equal(t, 3, players[0].Lives, "State should show lives per player")
+}
+
+func TestPlayerLivesNormalized(t *testing.T) {
+	settings := RoomSettings{PlayerLives: 0, TeamLives: 100}.normalized()
+
+	assert.Equal(t, DefaultPlayerLives, settings.PlayerLives, "Missing lives should fall back to the default")
+	assert.Equal(t, MaxLives, settings.TeamLives, "Lives should be capped")
+
+	settings = RoomSettings{Hints: true, PlayerLives: 1}.normalized()
+	assert.Equal(t, MinHintLives, settings.PlayerLives, "Hints should leave a life to pay with")
+
+	settings = RoomSettings{Hints: true, ScoreMode: true, PlayerLives: 1}.normalized()
+	assert.Equal(t, 1, settings.PlayerLives, "Score mode pays for hints with points")
+}
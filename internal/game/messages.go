@@ -0,0 +1,165 @@
+package game
+
+import "wordgame/internal/i18n"
+
+// Messages 는 게임 메시지 코드의 언어별 문장이다. {name} 은 메시지 인자로 바뀐다.
+var Messages = i18n.NewCatalog(map[string]map[string]string{
+	i18n.LocaleKorean: {
+		WAITINGFORPLAYERSMSG: "플레이어를 기다리는 중...",
+		AVAILABLEMSG:         "새 게임을 시작할 수 있습니다. 플레이어를 기다립니다.",
+		STARTMSG:             "게임이 시작되었습니다! {player}님부터 시작하세요.",
+		ELIMINATEDMSG:        "{player}님이 탈락했습니다. 이유 : {reason}",
+		WINNERMSG:            "{player}님이 승리했습니다!",
+		EXITMSG:              "{player}님이 게임에서 나갔습니다.",
+		ALLEXITMSG:           "모든 플레이어가 나갔습니다. 새로운 플레이어를 기다립니다.",
+		CURRENTTURNMSG:       "{player}님의 차례입니다.",
+		TEAMNAMEMSG:          "{team}팀",
+		WINNERTEAMMSG:        "{team}팀이 승리했습니다!",
+		TEAMLIFELOSTMSG:      "{team}팀이 목숨을 하나 잃었습니다. (남은 목숨 {lives}) 이유 : {reason}",
+		TEAMELIMINATEDMSG:    "{team}팀이 탈락했습니다. 이유 : {reason}",
+		LIFELOSTMSG:          "{player}님이 목숨을 하나 잃었습니다. (남은 목숨 {lives}) 이유 : {reason}",
+		SCOREPENALTYMSG:      "{player}님이 {points}점을 잃었습니다. 이유 : {reason}",
+		SCOREWINNERMSG:       "{player}님이 {score}점으로 1위입니다!",
+		SCOREENDMSG:          "게임이 끝났습니다.",
+		PLAYERREADYMSG:       "{player}님이 준비했습니다.",
+		PLAYERNOTREADYMSG:    "{player}님이 준비를 취소했습니다.",
+		COUNTDOWNMSG:         "{seconds}초 뒤에 게임이 시작됩니다.",
+		COUNTDOWNCANCELMSG:   "{player}님 때문에 게임 시작이 취소되었습니다.",
+
+		GAMEALREADYSTARTEDMSG:   "이미 게임이 시작되었습니다.",
+		NOHOSTPRIVILEGESMSG:     "게임을 시작할 권한이 없습니다. 호스트만 게임을 시작할 수 있습니다.",
+		MINPLAYERTOSTARTMSG:     "게임을 시작하려면 최소 {count}명의 플레이어가 필요합니다.",
+		NOTTOHANDLEPLAYMSG:      "현재 게임이 시작되지 않았으므로 단어를 제출할 수 없습니다.",
+		NOTCURRENTPLAYERSMSG:    "현재 당신의 차례가 아닙니다.",
+		TEAMSNOTREADYMSG:        "팀 모드에서는 최소 {count}개 팀에 플레이어가 있어야 합니다.",
+		TEAMMODEDISABLEDMSG:     "팀 모드가 아닌 방입니다.",
+		NOHOSTTEAMMSG:           "방장만 팀을 정할 수 있습니다.",
+		INVALIDTEAMMSG:          "없는 팀입니다.",
+		TEAMUSERNOTFOUNDMSG:     "플레이어를 찾을 수 없습니다.",
+		NOHOSTBOTMSG:            "방장만 봇을 넣거나 뺄 수 있습니다.",
+		INVALIDBOTDIFFICULTYMSG: "없는 봇 난이도입니다.",
+		BOTNOTFOUNDMSG:          "봇을 찾을 수 없습니다.",
+		HINTMSG:                 "힌트 : '{prefix}'(으)로 시작하는 {length}글자 단어가 있습니다.",
+		HINTUSEDMSG:             "{player}님이 힌트를 사용했습니다.",
+		HINTDISABLEDMSG:         "힌트를 쓸 수 없는 방입니다.",
+		NOHINTMSG:               "이어 낼 수 있는 단어가 없습니다.",
+		HINTNOTAFFORDABLEMSG:    "남은 목숨이 하나뿐이라 힌트를 받을 수 없습니다.",
+		PLAYERSNOTREADYMSG:      "준비한 플레이어가 부족합니다. ({ready}/{needed})",
+		READYNOTPLAYERMSG:       "플레이어만 준비할 수 있습니다.",
+		DICTIONARYEMPTYMSG:      "{language} 사전에 단어가 없어 게임을 시작할 수 없습니다.",
+
+		TYPEWORDMSG:              "단어를 입력하세요.",
+		MINWORDLENGTHMSG:         "단어는 최소 {length}자 이상이어야 합니다.",
+		WORDALREADYUSEDMSG:       "이미 사용된 단어입니다.",
+		WORDNOTINDICTMSG:         "사전에 없는 단어입니다.",
+		WORDMISMATCHMSG:          "끝말이 맞지 않습니다.",
+		DEADENDWORDMSG:           "한방 단어는 사용할 수 없습니다.",
+		FIRSTSYLLABLEMISMATCHMSG: "앞 단어의 첫 음절로 끝나야 합니다.",
+		LASTTWOMISMATCHMSG:       "앞 단어의 끝 두 음절로 시작해야 합니다.",
+
+		ROOMFULLMSG:             "방이 가득 찼습니다.",
+		JOINFAILEDMSG:           "방에 들어갈 수 없습니다. 잠시 후 다시 시도하세요.",
+		SERVERFULLMSG:           "서버 접속자가 너무 많습니다. 잠시 후 다시 시도하세요.",
+		TOOMANYCONNECTIONSMSG:   "같은 주소에서 접속한 연결이 너무 많습니다.",
+		ROOMNOTFOUNDMSG:         "방을 찾을 수 없습니다.",
+		REPLAYNOTFOUNDMSG:       "게임 기록을 찾을 수 없습니다.",
+		ADMINENDMSG:             "관리자가 게임을 종료했습니다.",
+		KICKEDMSG:               "관리자에 의해 방에서 퇴장되었습니다.",
+		ANNOUNCEMENTMSG:         "{text}",
+		ROOMCLOSEDMSG:           "관리자가 방을 닫았습니다.",
+		IDLEROOMCLOSEDMSG:       "오랫동안 활동이 없어 방이 닫혔습니다.",
+		SUGGESTIONRECEIVEDMSG:   "단어 추가 요청이 접수되었습니다. 관리자 검토 후 사전에 반영됩니다.",
+		SUGGESTIONNOTALLOWEDMSG: "사전에 없어 탈락한 단어만 추가를 요청할 수 있습니다.",
+		SUGGESTIONDUPLICATEMSG:  "이미 요청되었거나 사전에 있는 단어입니다.",
+		SUGGESTIONFAILEDMSG:     "단어 추가 요청을 처리하지 못했습니다.",
+		RATELIMITEDMSG:          "메시지를 너무 빠르게 보내고 있습니다. 잠시 후 다시 시도하세요.",
+		TOOMANYMESSAGESMSG:      "메시지를 너무 많이 보내 연결이 종료되었습니다.",
+	},
+	i18n.LocaleEnglish: {
+		WAITINGFORPLAYERSMSG: "Waiting for players...",
+		AVAILABLEMSG:         "A new game can be started. Waiting for players.",
+		STARTMSG:             "The game has started! {player} goes first.",
+		ELIMINATEDMSG:        "{player} has been eliminated. Reason: {reason}",
+		WINNERMSG:            "{player} wins!",
+		EXITMSG:              "{player} has left the game.",
+		ALLEXITMSG:           "All players have left. Waiting for new players.",
+		CURRENTTURNMSG:       "It's {player}'s turn.",
+		TEAMNAMEMSG:          "Team {team}",
+		WINNERTEAMMSG:        "Team {team} wins!",
+		TEAMLIFELOSTMSG:      "Team {team} lost a life. ({lives} left) Reason: {reason}",
+		TEAMELIMINATEDMSG:    "Team {team} has been eliminated. Reason: {reason}",
+		LIFELOSTMSG:          "{player} lost a life. ({lives} left) Reason: {reason}",
+		SCOREPENALTYMSG:      "{player} lost {points} points. Reason: {reason}",
+		SCOREWINNERMSG:       "{player} takes first place with {score} points!",
+		SCOREENDMSG:          "The game is over.",
+		PLAYERREADYMSG:       "{player} is ready.",
+		PLAYERNOTREADYMSG:    "{player} is no longer ready.",
+		COUNTDOWNMSG:         "The game starts in {seconds}...",
+		COUNTDOWNCANCELMSG:   "The game start was cancelled because of {player}.",
+
+		GAMEALREADYSTARTEDMSG:   "The game has already started.",
+		NOHOSTPRIVILEGESMSG:     "Only the host can start the game.",
+		MINPLAYERTOSTARTMSG:     "At least {count} players are needed to start the game.",
+		NOTTOHANDLEPLAYMSG:      "The game has not started, so words cannot be submitted.",
+		NOTCURRENTPLAYERSMSG:    "It's not your turn.",
+		TEAMSNOTREADYMSG:        "Team mode needs players on at least {count} teams.",
+		TEAMMODEDISABLEDMSG:     "This room is not in team mode.",
+		NOHOSTTEAMMSG:           "Only the host can assign teams.",
+		INVALIDTEAMMSG:          "That team does not exist.",
+		TEAMUSERNOTFOUNDMSG:     "Player not found.",
+		NOHOSTBOTMSG:            "Only the host can add or remove bots.",
+		INVALIDBOTDIFFICULTYMSG: "That bot difficulty does not exist.",
+		BOTNOTFOUNDMSG:          "Bot not found.",
+		HINTMSG:                 "Hint: there is a {length}-letter word starting with '{prefix}'.",
+		HINTUSEDMSG:             "{player} used a hint.",
+		HINTDISABLEDMSG:         "Hints are disabled in this room.",
+		NOHINTMSG:               "No word can follow.",
+		HINTNOTAFFORDABLEMSG:    "You need more than one life left to get a hint.",
+		PLAYERSNOTREADYMSG:      "Not enough players are ready. ({ready}/{needed})",
+		READYNOTPLAYERMSG:       "Only players can get ready.",
+		DICTIONARYEMPTYMSG:      "The {language} dictionary has no words, so the game cannot start.",
+
+		TYPEWORDMSG:              "Please enter a word.",
+		MINWORDLENGTHMSG:         "Words must be at least {length} characters long.",
+		WORDALREADYUSEDMSG:       "The word has already been used.",
+		WORDNOTINDICTMSG:         "The word is not in the dictionary.",
+		WORDMISMATCHMSG:          "The word does not start with the last syllable.",
+		DEADENDWORDMSG:           "Dead-end words are not allowed.",
+		FIRSTSYLLABLEMISMATCHMSG: "The word must end with the first syllable of the previous word.",
+		LASTTWOMISMATCHMSG:       "The word must start with the last two syllables of the previous word.",
+
+		ROOMFULLMSG:             "The room is full.",
+		JOINFAILEDMSG:           "Could not join the room. Please try again later.",
+		SERVERFULLMSG:           "The server is busy. Please try again later.",
+		TOOMANYCONNECTIONSMSG:   "Too many connections from the same address.",
+		ROOMNOTFOUNDMSG:         "Room not found.",
+		REPLAYNOTFOUNDMSG:       "Game replay not found.",
+		ADMINENDMSG:             "An administrator ended the game.",
+		KICKEDMSG:               "You were removed from the room by an administrator.",
+		ANNOUNCEMENTMSG:         "{text}",
+		ROOMCLOSEDMSG:           "An administrator closed the room.",
+		IDLEROOMCLOSEDMSG:       "The room was closed after a long period of inactivity.",
+		SUGGESTIONRECEIVEDMSG:   "Your word suggestion was received. It will be added after an administrator reviews it.",
+		SUGGESTIONNOTALLOWEDMSG: "You can only suggest the word you were eliminated for.",
+		SUGGESTIONDUPLICATEMSG:  "The word has already been suggested or is in the dictionary.",
+		SUGGESTIONFAILEDMSG:     "Your word suggestion could not be processed.",
+		RATELIMITEDMSG:          "You are sending messages too fast. Please try again shortly.",
+		TOOMANYMESSAGESMSG:      "The connection was closed because too many messages were sent.",
+	},
+})
+
+// render 는 메시지를 사용자 언어로 렌더링한다.
+func render(locale string, msg i18n.Message) string {
+	return Messages.Render(locale, msg)
+}
+
+// playerMessage 는 플레이어 이름과 ID를 인자로 가진 메시지를 만든다.
+func (g *Game) playerMessage(code string, user *User, keyValues ...any) i18n.Message {
+	args := append([]any{"player", g.makeNameToDisplay(user.Tag, user.Name), "playerId", user.Tag}, keyValues...)
+	return i18n.New(code, args...)
+}
+
+func teamMessage(code string, team int, keyValues ...any) i18n.Message {
+	args := append([]any{"team", team + 1}, keyValues...)
+	return i18n.New(code, args...)
+}
@@ -0,0 +1,36 @@
+package game
+
+import (
+	"testing"
+
+	"wordgame/internal/i18n"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessagesHaveEveryLocale(t *testing.T) {
+	codes := []string{
+		STARTMSG, ELIMINATEDMSG, WINNERMSG, CURRENTTURNMSG, WINNERTEAMMSG, LIFELOSTMSG,
+		WORDNOTINDICTMSG, WORDMISMATCHMSG, MINWORDLENGTHMSG, ROOMFULLMSG, JOINFAILEDMSG, SUGGESTIONRECEIVEDMSG,
+		PLAYERREADYMSG, COUNTDOWNMSG, COUNTDOWNCANCELMSG, PLAYERSNOTREADYMSG,
+	}
+	for _, code := range codes {
+		assert.NotEqual(t, code, render(i18n.LocaleKorean, i18n.New(code)), "Korean message should exist for %s", code)
+		assert.NotEqual(t, code, render(i18n.LocaleEnglish, i18n.New(code)), "English message should exist for %s", code)
+		assert.NotEqual(t, render(i18n.LocaleKorean, i18n.New(code)), render(i18n.LocaleEnglish, i18n.New(code)), "English message should be translated for %s", code)
+	}
+}
+
+func TestSendFormPerLocale(t *testing.T) {
+	g := SetupTestGame()
+	alice := &User{ID: "1001", Name: "Alice", Tag: "1234"}
+	g.message = g.playerMessage(ELIMINATEDMSG, alice, "reason", i18n.New(WORDNOTINDICTMSG, "word", "qwerty"))
+
+	ko := g.makeSendForm(i18n.LocaleKorean, nil, nil, nil)
+	en := g.makeSendForm(i18n.LocaleEnglish, nil, nil, nil)
+
+	assert.Equal(t, "Alice#1234님이 탈락했습니다. 이유 : 사전에 없는 단어입니다.", ko["message"])
+	assert.Equal(t, "Alice#1234 has been eliminated. Reason: The word is not in the dictionary.", en["message"])
+	assert.Equal(t, ELIMINATEDMSG, en["messageCode"], "Clients should get the message code")
+	assert.Equal(t, alice.Tag, g.message.Params["playerId"], "Params should carry the public player ID")
+}
@@ -0,0 +1,23 @@
+package game
+
+import "wordgame/internal/metrics"
+
+// rejectReasons 는 거절 사유 메시지를 지표 라벨로 바꾼다.
+var rejectReasons = map[string]string{
+	NOTTOHANDLEPLAYMSG:   "not_started",
+	NOTCURRENTPLAYERSMSG: "not_current_turn",
+	TYPEWORDMSG:          "blank",
+	MINWORDLENGTHMSG:     "too_short",
+	WORDALREADYUSEDMSG:   "already_used",
+	WORDMISMATCHMSG:      "mismatch",
+	WORDNOTINDICTMSG:     "not_in_dict",
+	DEADENDWORDMSG:       "dead_end",
+}
+
+func recordRejection(reason string) {
+	label, ok := rejectReasons[reason]
+	if !ok {
+		label = "other"
+	}
+	metrics.WordsRejected.WithLabelValues(label).Inc()
+}
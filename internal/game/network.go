@@ -2,30 +2,69 @@ package game
 
 import (
 	"encoding/json"
-	"log"
+	"errors"
+
+	"wordgame/internal/i18n"
+	"wordgame/internal/logging"
+	"wordgame/internal/metrics"
 
 	"github.com/gofiber/contrib/websocket"
 	"github.com/gofiber/fiber/v2"
 )
 
 type WelcomeMessage struct {
-	Type   string `json:"type"`
-	YourId string `json:"yourId"`
+	Type    string `json:"type"`
+	YourId  string `json:"yourId"`
+	YourTag string `json:"yourTag"`
+}
+
+// PlayerInfo 의 ID 는 방 안에서만 쓰는 공개 ID(태그)다. 세션 ID 는 환영 메시지로 본인에게만 보낸다.
+type PlayerInfo struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Tag         string `json:"tag"`
+	DisplayName string `json:"displayName"`
+	Lives       int    `json:"lives"`
+	Score       int    `json:"score"`
+	Bot         string `json:"bot,omitempty"` // 봇이면 난이도
+	Ready       bool   `json:"ready"`
+}
+
+// NoticeMessage 의 Message 는 받는 사람의 언어로 렌더링한 문장이고,
+// MessageCode 와 MessageParams 는 클라이언트가 직접 다룰 수 있는 메시지 코드와 인자다.
+type NoticeMessage struct {
+	Type          string         `json:"type"`
+	Code          string         `json:"code"`
+	Message       string         `json:"message"`
+	MessageCode   string         `json:"messageCode"`
+	MessageParams map[string]any `json:"messageParams,omitempty"`
 }
 
-func (g *Game) AddClient(conn *websocket.Conn, name string) {
-	id := g.generateUniqueID()
-	user := NewUser(conn, id, name)
+func (g *Game) AddClient(conn Conn, name, locale string) {
+	id, err := generateUserID()
+	if err != nil {
+		logging.Error(g.logger, USERIDERRORLOGMSG, logging.ErrorKey, err)
+		_ = conn.Close()
+		return
+	}
+	user := NewUser(conn, id, name, locale, g.logger)
 	user.game = g
+	user.setMessageLimit(g.limits)
 
-	g.room.register <- user
-	g.addUser(user)
+	if err := g.addUser(user); err != nil {
+		logging.Warn(g.logger, REJECTCONNECTIONLOGMSG, logging.UserIDKey, user.ID, logging.ErrorKey, err)
+		code, message := joinRejection(err)
+		RejectConnection(conn, locale, code, message)
+		return
+	}
+	g.room.Register(user)
+	metrics.ConnectedUsers.Inc()
 	welcome := g.makeWelcomeMessage(user)
 
 	if welcomeJson, err := json.Marshal(welcome); err == nil {
 		g.sendMessageToUser(user, welcomeJson)
 	} else {
-		log.Println(MARSHALERROR, err)
+		logging.Error(g.logger, MARSHALERROR, logging.ErrorKey, err)
 	}
 
 	g.handleAfterConnect(user)
@@ -35,9 +74,10 @@ func (g *Game) HandleMessage(user *User, msg []byte) {
 	var gameMessage GameMessage
 
 	if err := json.Unmarshal(msg, &gameMessage); err != nil {
-		log.Println(UNMARSHALERROR, err)
+		logging.Warn(g.logger, UNMARSHALERROR, logging.UserIDKey, user.ID, logging.ErrorKey, err)
 		return
 	}
+	g.touch()
 
 	switch gameMessage.Type {
 	case STARTJSONTYPE:
@@ -45,8 +85,22 @@ func (g *Game) HandleMessage(user *User, msg []byte) {
 		g.broadcastGameState()
 	case SUBMITJSONTYPE:
 		g.handleSubmit(user, gameMessage)
+	case SUGGESTJSONTYPE:
+		g.handleSuggest(user, gameMessage)
+	case ASSIGNTEAMJSONTYPE:
+		g.handleAssignTeam(user, gameMessage)
+	case BALANCETEAMSJSONTYPE:
+		g.handleBalanceTeams(user)
+	case ADDBOTJSONTYPE:
+		g.handleAddBot(user, gameMessage)
+	case REMOVEBOTJSONTYPE:
+		g.handleRemoveBot(user, gameMessage)
+	case REQUESTHINTJSONTYPE:
+		g.handleRequestHint(user)
+	case TOGGLEREADYJSONTYPE:
+		g.handleToggleReady(user)
 	default:
-		log.Println(UNKNOWNMESSAGETYPE, gameMessage.Type)
+		logging.Warn(g.logger, UNKNOWNMESSAGETYPE, logging.UserIDKey, user.ID, "type", gameMessage.Type)
 	}
 }
 
@@ -56,23 +110,29 @@ func (g *Game) broadcastGameState() {
 
 	players := g.makePlayerList()
 	spectators := g.makeSpectatorList()
-	stateToSend := g.makeSendForm(players, spectators)
-	bytes, err := json.Marshal(stateToSend)
-	if err != nil {
-		log.Println(MARSHALERROR, err)
-		return
+	hint := g.makeHintInfo()
+	// 같은 방이라도 접속자마다 언어가 다를 수 있어 언어별로 따로 만든다.
+	states := make(LocalizedMessage, len(i18n.Locales))
+	for _, locale := range i18n.Locales {
+		bytes, err := json.Marshal(g.makeSendForm(locale, players, spectators, hint))
+		if err != nil {
+			logging.Error(g.logger, MARSHALERROR, logging.ErrorKey, err)
+			return
+		}
+		states[locale] = bytes
 	}
-	log.Printf(BROADCASTLOGMSG, g.RoomId, string(bytes))
-	g.room.broadcast <- bytes
+	logging.Debug(g.logger, BROADCASTLOGMSG, "state", string(states.For(i18n.DefaultLocale)))
+	g.room.Broadcast(states)
+	g.recordState(states)
+	// 상태가 바뀌면 항상 브로드캐스트하므로, 여기서 봇의 차례인지 확인한다.
+	g.scheduleBotTurn()
 }
 
+// handleSubmit 은 단어를 꺼내 handlePlay 에 넘긴다. 지금 단어를 받을 수 있는지는 handlePlay 가 판단한다.
 func (g *Game) handleSubmit(user *User, gameMessage GameMessage) {
-	if !g.started {
-		return
-	}
 	word, ok := gameMessage.Payload.(string)
 	if !ok {
-		log.Println(SUBMITPAYLOADERROR, gameMessage.Payload)
+		logging.Warn(g.logger, SUBMITPAYLOADERROR, logging.UserIDKey, user.ID, "payload", gameMessage.Payload)
 		return
 	}
 	g.handlePlay(user, word)
@@ -80,8 +140,9 @@ func (g *Game) handleSubmit(user *User, gameMessage GameMessage) {
 
 func (g *Game) makeWelcomeMessage(user *User) WelcomeMessage {
 	return WelcomeMessage{
-		Type:   "welcome",
-		YourId: user.ID,
+		Type:    WELCOMEJSONTYPE,
+		YourId:  user.ID,
+		YourTag: user.Tag,
 	}
 }
 
@@ -92,43 +153,122 @@ func (g *Game) handleAfterConnect(user *User) {
 }
 
 func (g *Game) handleClientDisconnect(user *User) {
+	metrics.ConnectedUsers.Dec()
 	user.Close()
-	g.room.unregister <- user
+	g.room.Unregister(user)
 	g.removeUser(user)
 	g.broadcastGameState()
 }
 
 func (g *Game) sendMessageToUser(user *User, json []byte) {
 	if err := user.WriteMessage(websocket.TextMessage, json); err != nil {
-		log.Printf(FAILSENDWELCOME, user.ID, err)
+		logging.Warn(g.logger, FAILSENDWELCOME, logging.UserIDKey, user.ID, logging.ErrorKey, err)
 	}
 }
 
-func (g *Game) makePlayerList() []string {
-	players := make([]string, len(g.players))
+func (g *Game) sendNotice(user *User, code string, message i18n.Message) {
+	if user.IsBot() {
+		return
+	}
+	bytes, err := json.Marshal(makeNoticeMessage(NOTICEJSONTYPE, user.Locale, code, message))
+	if err != nil {
+		logging.Error(g.logger, MARSHALERROR, logging.ErrorKey, err)
+		return
+	}
+	if err := user.WriteMessage(websocket.TextMessage, bytes); err != nil {
+		logging.Warn(g.logger, FAILSENDNOTICE, logging.UserIDKey, user.ID, logging.ErrorKey, err)
+	}
+}
+
+// RejectConnection 은 게임에 들어오기 전에 거절된 연결에 오류 코드를 보내고 닫는다.
+// joinRejection 은 addUser 가 실패한 이유에 맞는 거절 코드와 메시지를 고른다.
+func joinRejection(err error) (string, i18n.Message) {
+	if errors.Is(err, ErrRoomFull) {
+		return ROOMFULLCODE, i18n.New(ROOMFULLMSG)
+	}
+	return JOINFAILEDCODE, i18n.New(JOINFAILEDMSG)
+}
+
+func RejectConnection(conn Conn, locale, code string, message i18n.Message) {
+	bytes, err := json.Marshal(makeNoticeMessage(ERRORJSONTYPE, locale, code, message))
+	if err == nil {
+		_ = conn.WriteMessage(websocket.TextMessage, bytes)
+	}
+	_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, code))
+	_ = conn.Close()
+}
+
+func (g *Game) broadcastNotice(code string, message i18n.Message) {
+	notices := make(LocalizedMessage, len(i18n.Locales))
+	for _, locale := range i18n.Locales {
+		bytes, err := json.Marshal(makeNoticeMessage(NOTICEJSONTYPE, locale, code, message))
+		if err != nil {
+			logging.Error(g.logger, MARSHALERROR, logging.ErrorKey, err)
+			return
+		}
+		notices[locale] = bytes
+	}
+	g.room.Broadcast(notices)
+}
+
+func makeNoticeMessage(messageType, locale, code string, message i18n.Message) NoticeMessage {
+	return NoticeMessage{
+		Type:          messageType,
+		Code:          code,
+		Message:       render(locale, message),
+		MessageCode:   message.Code,
+		MessageParams: message.Params,
+	}
+}
+
+func (g *Game) makePlayerList() []PlayerInfo {
+	players := make([]PlayerInfo, len(g.players))
 	for i, player := range g.players {
-		players[i] = g.makeNameToDisplay(player.ID, player.Name)
+		players[i] = g.makePlayerInfo(player)
 	}
 	return players
 }
 
-func (g *Game) makeSpectatorList() []string {
-	spectators := make([]string, len(g.spectators))
+func (g *Game) makeSpectatorList() []PlayerInfo {
+	spectators := make([]PlayerInfo, len(g.spectators))
 	for i, spectator := range g.spectators {
-		spectators[i] = g.makeNameToDisplay(spectator.ID, spectator.Name)
+		spectators[i] = g.makePlayerInfo(spectator)
 	}
 	return spectators
 }
 
-func (g *Game) makeSendForm(players, spectators []string) fiber.Map {
+func (g *Game) makePlayerInfo(user *User) PlayerInfo {
+	return PlayerInfo{
+		ID:          user.Tag,
+		Name:        user.Name,
+		Tag:         user.Tag,
+		DisplayName: g.makeNameToDisplay(user.Tag, user.Name),
+		Lives:       g.livesOf(user),
+		Score:       g.currentScore(user),
+		Bot:         user.BotDifficulty,
+		Ready:       g.isPlayer(user.ID) && g.isReady(user),
+	}
+}
+
+func (g *Game) makeSendForm(locale string, players, spectators []PlayerInfo, hint *HintInfo) fiber.Map {
 	return fiber.Map{
 		"lastWord":            g.lastWord,
 		"players":             players,
 		"spectators":          spectators,
-		"currentTurnPlayerId": g.currentUserID,
-		"hostUserId":          g.hostUserId,
-		"isGameOver":          g.gameover,
-		"isStarted":           g.started,
-		"message":             g.message,
+		"currentTurnPlayerId": g.publicID(g.currentUserID),
+		"hostUserId":          g.publicID(g.hostUserId),
+		"phase":               g.state.Phase(),
+		"isGameOver":          g.isGameOver(),
+		"isStarted":           g.isStarted(),
+		"countdown":           g.countdown, // 카운트다운 중 남은 초. 그 밖에는 0
+		"message":             render(locale, g.message),
+		"messageCode":         g.message.Code,
+		"messageParams":       g.message.Params,
+		"settings":            g.settings,
+		"teams":               g.makeTeamList(locale),
+		"standings":           g.standings,
+		"wordList":            g.makeWordListInfo(),
+		"hint":                hint,
+		"replayId":            g.replayID, // 마지막으로 끝난 게임의 기록 번호. 없으면 0
 	}
 }
@@ -0,0 +1,128 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+
+	"wordgame/internal/logging"
+)
+
+// Phase 는 게임 진행 단계다.
+//
+//	Lobby → Countdown → InTurn → RoundOver → InTurn → ... → GameOver → Lobby
+type Phase string
+
+const (
+	PhaseLobby     Phase = "lobby"      // 방장이 시작하기를 기다린다
+	PhaseCountdown Phase = "countdown"  // 시작 직전. 취소하면 로비로 돌아간다
+	PhaseInTurn    Phase = "in_turn"    // 차례인 플레이어가 단어를 낸다
+	PhaseRoundOver Phase = "round_over" // 탈락자가 나와 새 라운드를 준비한다
+	PhaseGameOver  Phase = "game_over"  // 결과를 보여주고 리셋을 기다린다
+)
+
+// PhaseEvent 는 단계를 바꾸는 사건이다.
+type PhaseEvent string
+
+const (
+	PhaseEventStart      PhaseEvent = "start"       // 방장이 게임을 시작했다
+	PhaseEventCancel     PhaseEvent = "cancel"      // 카운트다운을 취소했다
+	PhaseEventRoundStart PhaseEvent = "round_start" // 시작 단어와 첫 차례를 정했다
+	PhaseEventPlayerOut  PhaseEvent = "player_out"  // 플레이어가 탈락해 라운드가 끝났다
+	PhaseEventFinish     PhaseEvent = "finish"      // 우승자가 정해졌거나 게임을 강제로 끝냈다
+	PhaseEventReset      PhaseEvent = "reset"       // 게임을 치우고 로비로 돌아간다
+)
+
+var ErrInvalidTransition = errors.New("invalid phase transition")
+
+// phaseTransitions 는 단계마다 받을 수 있는 사건과 그 다음 단계다. 여기에 없는 사건은 거절한다.
+// 리셋은 플레이어가 모두 나가는 경우처럼 어느 단계에서든 일어날 수 있다.
+var phaseTransitions = map[Phase]map[PhaseEvent]Phase{
+	PhaseLobby: {
+		PhaseEventStart: PhaseCountdown,
+		PhaseEventReset: PhaseLobby,
+	},
+	PhaseCountdown: {
+		PhaseEventRoundStart: PhaseInTurn,
+		PhaseEventCancel:     PhaseLobby,
+		PhaseEventFinish:     PhaseGameOver,
+		PhaseEventReset:      PhaseLobby,
+	},
+	PhaseInTurn: {
+		PhaseEventPlayerOut: PhaseRoundOver,
+		PhaseEventFinish:    PhaseGameOver,
+		PhaseEventReset:     PhaseLobby,
+	},
+	PhaseRoundOver: {
+		PhaseEventRoundStart: PhaseInTurn,
+		PhaseEventFinish:     PhaseGameOver,
+		PhaseEventReset:      PhaseLobby,
+	},
+	PhaseGameOver: {
+		PhaseEventReset: PhaseLobby,
+	},
+}
+
+// StateMachine 은 게임 단계를 들고 허용된 전이만 받아들인다. (잠금은 호출자가 관리)
+type StateMachine struct {
+	phase Phase
+}
+
+func NewStateMachine() StateMachine {
+	return StateMachine{phase: PhaseLobby}
+}
+
+func (m *StateMachine) Phase() Phase {
+	return m.phase
+}
+
+func (m *StateMachine) Can(event PhaseEvent) bool {
+	_, ok := phaseTransitions[m.phase][event]
+	return ok
+}
+
+// Fire 는 사건을 적용해 다음 단계로 옮긴다. 허용되지 않은 사건이면 단계를 그대로 두고 오류를 돌려준다.
+func (m *StateMachine) Fire(event PhaseEvent) (Phase, error) {
+	next, ok := phaseTransitions[m.phase][event]
+	if !ok {
+		return m.phase, fmt.Errorf("%w: %s in %s", ErrInvalidTransition, event, m.phase)
+	}
+	m.phase = next
+	return next, nil
+}
+
+// 아래 함수들은 모두 잠금을 호출자가 관리한다.
+
+// transition 은 게임 단계를 옮긴다. 허용되지 않은 전이는 로그만 남기고 false 를 돌려준다.
+func (g *Game) transition(event PhaseEvent) bool {
+	from := g.state.Phase()
+	to, err := g.state.Fire(event)
+	if err != nil {
+		logging.Warn(g.logger, PHASEERRORLOGMSG, "event", event, "phase", from)
+		return false
+	}
+	logging.Debug(g.logger, PHASELOGMSG, "event", event, "from", from, "to", to)
+	return true
+}
+
+// isStarted 는 로비를 벗어났는지 본다. 게임이 끝나 리셋을 기다리는 동안에도 참이다.
+func (g *Game) isStarted() bool {
+	return g.state.Phase() != PhaseLobby
+}
+
+// inGame 은 게임이 시작되어 아직 끝나지 않았는지 본다.
+func (g *Game) inGame() bool {
+	switch g.state.Phase() {
+	case PhaseCountdown, PhaseInTurn, PhaseRoundOver:
+		return true
+	}
+	return false
+}
+
+// isTurnOpen 은 차례인 플레이어가 지금 단어를 낼 수 있는지 본다.
+func (g *Game) isTurnOpen() bool {
+	return g.state.Phase() == PhaseInTurn
+}
+
+func (g *Game) isGameOver() bool {
+	return g.state.Phase() == PhaseGameOver
+}
@@ -0,0 +1,131 @@
+package game
+
+import (
+	"testing"
+
+	"wordgame/internal/i18n"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateMachineTransitions(t *testing.T) {
+	testCases := []struct {
+		from  Phase
+		event PhaseEvent
+		want  Phase
+		ok    bool
+	}{
+		{PhaseLobby, PhaseEventStart, PhaseCountdown, true},
+		{PhaseLobby, PhaseEventRoundStart, PhaseLobby, false},
+		{PhaseLobby, PhaseEventFinish, PhaseLobby, false},
+		{PhaseCountdown, PhaseEventRoundStart, PhaseInTurn, true},
+		{PhaseCountdown, PhaseEventCancel, PhaseLobby, true},
+		{PhaseCountdown, PhaseEventStart, PhaseCountdown, false},
+		{PhaseInTurn, PhaseEventPlayerOut, PhaseRoundOver, true},
+		{PhaseInTurn, PhaseEventFinish, PhaseGameOver, true},
+		{PhaseInTurn, PhaseEventCancel, PhaseInTurn, false},
+		{PhaseRoundOver, PhaseEventRoundStart, PhaseInTurn, true},
+		{PhaseRoundOver, PhaseEventFinish, PhaseGameOver, true},
+		{PhaseGameOver, PhaseEventReset, PhaseLobby, true},
+		{PhaseGameOver, PhaseEventStart, PhaseGameOver, false},
+		{PhaseGameOver, PhaseEventRoundStart, PhaseGameOver, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.from)+"/"+string(tc.event), func(t *testing.T) {
+			m := StateMachine{phase: tc.from}
+
+			assert.Equal(t, tc.ok, m.Can(tc.event))
+			next, err := m.Fire(tc.event)
+
+			if tc.ok {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidTransition)
+			}
+			assert.Equal(t, tc.want, next)
+			assert.Equal(t, tc.want, m.Phase(), "Rejected events should leave the phase unchanged")
+		})
+	}
+}
+
+func TestStateMachineResetFromAnyPhase(t *testing.T) {
+	for phase := range phaseTransitions {
+		m := StateMachine{phase: phase}
+		next, err := m.Fire(PhaseEventReset)
+
+		assert.NoError(t, err, "Reset should be allowed from %s", phase)
+		assert.Equal(t, PhaseLobby, next)
+	}
+}
+
+func TestGamePhaseCycle(t *testing.T) {
+	g := SetupDefaultPlayers()
+	assert.Equal(t, PhaseLobby, g.state.Phase())
+
+	startReadyGame(g, g.players[0])
+	assert.Equal(t, PhaseInTurn, g.state.Phase(), "Starting should go through the countdown to the first turn")
+
+	g.mu.Lock()
+	winner, _ := g.eliminatePlayer(g.players[1], i18n.New(WORDNOTINDICTMSG))
+	g.mu.Unlock()
+	assert.False(t, winner)
+	assert.Equal(t, PhaseInTurn, g.state.Phase(), "Round should restart after an elimination")
+
+	g.endGame(i18n.New(ADMINENDMSG))
+	assert.Equal(t, PhaseGameOver, g.state.Phase())
+
+	FakeClock(g).Advance(GameResetDelay)
+	assert.Equal(t, PhaseLobby, g.state.Phase())
+}
+
+func TestEliminationStartsNewRound(t *testing.T) {
+	g := SetupDefaultPlayers()
+	startReadyGame(g, g.players[0])
+	out := g.players[1]
+	g.lastWord = "사과"
+	g.usedWords["사과"] = true
+
+	winner, _ := g.eliminatePlayer(out, i18n.New(WORDNOTINDICTMSG))
+
+	require.False(t, winner)
+	assert.Len(t, g.players, 2)
+	assert.Equal(t, g.startword, g.lastWord, "New round should begin from a new start word")
+	assert.Equal(t, map[string]bool{g.startword: true}, g.usedWords, "Used words should be cleared for the new round")
+	assert.NotEqual(t, out.ID, g.currentUserID, "Eliminated player should not get the first turn")
+	assert.Equal(t, i18n.SequenceCode, g.message.Code, "Elimination and new round messages should both be shown")
+}
+
+func TestLastEliminationEndsGameWithoutNewRound(t *testing.T) {
+	g := SetupDefaultPlayers()
+	startReadyGame(g, g.players[0])
+	g.eliminatePlayer(g.players[2], i18n.New(WORDNOTINDICTMSG))
+
+	winner, msg := g.eliminatePlayer(g.players[1], i18n.New(WORDNOTINDICTMSG))
+
+	assert.True(t, winner)
+	assert.Equal(t, WINNERMSG, msg.Code)
+	assert.Equal(t, PhaseGameOver, g.state.Phase())
+}
+
+func TestHandlePlayAfterGameOver(t *testing.T) {
+	g := SetupDefaultPlayers()
+	startReadyGame(g, g.players[0])
+	current := g.findUser(g.currentUserID)
+	g.endGame(i18n.New(ADMINENDMSG))
+	last := g.lastWord
+
+	g.handlePlay(current, "과일")
+
+	assert.Equal(t, last, g.lastWord, "Words should not be accepted once the game is over")
+	assert.Equal(t, NOTTOHANDLEPLAYMSG, g.message.Code)
+}
+
+func TestHandleSubmitInLobby(t *testing.T) {
+	g := SetupDefaultPlayers()
+
+	g.handleSubmit(g.players[0], GameMessage{Type: SUBMITJSONTYPE, Payload: "사과"})
+
+	assert.Equal(t, NOTTOHANDLEPLAYMSG, g.message.Code, "Submitting in the lobby should be answered like any other rejected play")
+}
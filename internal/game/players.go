@@ -1,28 +1,70 @@
 package game
 
-import "log"
+import (
+	"wordgame/internal/i18n"
+	"wordgame/internal/logging"
+)
 
-func (g *Game) addUser(user *User) {
+func (g *Game) addUser(user *User) error {
 	g.mu.Lock()
 	defer g.mu.Unlock()
 	g.checkPlayerExist(user)
+	if g.isPlayerSlotFull() && g.isSpectatorSlotFull() {
+		return ErrRoomFull
+	}
+	if user.Tag == "" {
+		if err := g.assignTag(user); err != nil {
+			return err
+		}
+	}
+	g.connected = true
+	g.lastActivity = g.clock.Now()
+	if g.isPlayerSlotFull() {
+		// 플레이어 자리가 없으면 관전자로 들어와 다음 게임을 기다린다.
+		g.spectators = append(g.spectators, user)
+		g.recordUser(ReplayEventSpectate, user)
+		logging.Info(g.logger, ENTERSPECTATORLOGMSG, logging.UserIDKey, user.ID, logging.UserNameKey, user.Name, "tag", user.Tag)
+		return nil
+	}
 	g.players = append(g.players, user)
+	g.assignTeamOnJoin(user)
+	g.seatMidGameJoiner(user)
+	g.recordUser(ReplayEventJoin, user)
 
 	if len(g.players) == 1 {
 		g.handleRoomInit(user)
 	}
-	log.Printf(ENTERPLAYERLOGMSG, user.Name, user.ID)
+	logging.Info(g.logger, ENTERPLAYERLOGMSG, logging.UserIDKey, user.ID, logging.UserNameKey, user.Name, "tag", user.Tag)
+	return nil
 }
 
 func (g *Game) removeUser(user *User) {
 	g.mu.Lock()
+	g.recordReplay(ReplayEvent{Type: ReplayEventLeave, UserID: user.Tag})
+	if g.isPlayer(user.ID) {
+		g.cancelCountdown(user)
+	}
 	for i, p := range g.players {
 		g.handleDeleteUser(p, user, i)
 	}
 	for i, s := range g.spectators {
 		g.handleDeleteSpectator(s, user, i)
 	}
+	delete(g.rejectedWords, user.ID)
+	delete(g.lives, user.ID)
+	delete(g.ready, user.ID)
+	if len(g.players) > 0 && !g.hasHumanPlayer() {
+		g.removeBots()
+		g.handleAllPlayersLeft()
+	}
+	winner, msg := g.handleTeamLeft(user)
+	if !winner {
+		winner, msg = g.handleScoreLeft(user)
+	}
 	g.mu.Unlock()
+	if winner {
+		g.endGame(msg)
+	}
 	g.deleteRoom()
 }
 
@@ -35,9 +77,9 @@ func (g *Game) setNextPlayerTurn(currentUserID string) {
 	for i, p := range g.players {
 		if p.ID == currentUserID {
 			nextPlayerIndex := (i + 1) % len(g.players)
-			g.currentUserID = g.players[nextPlayerIndex].ID
-			nextPlayerName := g.players[nextPlayerIndex].Name
-			g.message = g.makeNameToDisplay(g.currentUserID, nextPlayerName) + CURRENTTURNMSG
+			nextPlayer := g.players[nextPlayerIndex]
+			g.currentUserID = nextPlayer.ID
+			g.message = g.playerMessage(CURRENTTURNMSG, nextPlayer)
 			return
 		}
 	}
@@ -45,7 +87,7 @@ func (g *Game) setNextPlayerTurn(currentUserID string) {
 
 func (g *Game) handleRoomInit(user *User) {
 	g.hostUserId = user.ID
-	log.Printf(HOSTLOGMSG, user.Name, user.ID)
+	logging.Info(g.logger, HOSTLOGMSG, logging.UserIDKey, user.ID, logging.UserNameKey, user.Name)
 	g.reset()
 }
 
@@ -61,7 +103,7 @@ func (g *Game) handleDeleteUser(target, user *User, index int) {
 
 	if target.ID == user.ID {
 		g.players = append(g.players[:index], g.players[index+1:]...)
-		log.Printf(EXITPLAYERLOGMSG, user.Name, user.ID)
+		logging.Info(g.logger, EXITPLAYERLOGMSG, logging.UserIDKey, user.ID, logging.UserNameKey, user.Name)
 		g.handleHostLeft(user)
 		g.makeNewPlayerTurn(user, index)
 	}
@@ -70,7 +112,7 @@ func (g *Game) handleDeleteUser(target, user *User, index int) {
 func (g *Game) handleDeleteSpectator(target, user *User, index int) {
 	if target.ID == user.ID {
 		g.spectators = append(g.spectators[:index], g.spectators[index+1:]...)
-		log.Printf(REMOVESPECTATORLOGMSG, user.Name, user.ID)
+		logging.Info(g.logger, REMOVESPECTATORLOGMSG, logging.UserIDKey, user.ID, logging.UserNameKey, user.Name)
 	}
 }
 
@@ -81,32 +123,49 @@ func (g *Game) handleHostLeft(user *User) {
 }
 
 func (g *Game) makeNewHost() {
-	if len(g.players) > 0 {
-		randomUser := g.players[g.random.MakeRandomNumber(0, len(g.players))].ID
+	humans := withoutBots(g.players)
+	if len(humans) > 0 {
+		randomUser := humans[g.random.MakeRandomNumber(0, len(humans))].ID
 		g.hostUserId = randomUser
-		log.Printf(HOSTCHANGELOGMSG, randomUser)
+		logging.Info(g.logger, HOSTCHANGELOGMSG, logging.UserIDKey, randomUser)
 	} else {
 		g.hostUserId = ""
 	}
 }
 
 func (g *Game) makeNewPlayerTurn(user *User, index int) {
-	if g.currentUserID == user.ID && len(g.players) > 0 && !g.gameover {
+	if g.settings.TeamMode && g.inGame() && g.currentUserID == user.ID && len(g.players) > 0 {
+		g.setNextTeamTurn()
+		g.message = i18n.Join(g.playerMessage(EXITMSG, user), g.message)
+	} else if g.inGame() && g.currentUserID == user.ID && len(g.players) > 0 {
 		nextPlayerIndex := index % len(g.players)
-		g.currentUserID = g.players[nextPlayerIndex].ID
-		g.message = EXITMSG + g.currentUserID
+		nextPlayer := g.players[nextPlayerIndex]
+		g.currentUserID = nextPlayer.ID
+		g.message = i18n.Join(g.playerMessage(EXITMSG, user), g.playerMessage(CURRENTTURNMSG, nextPlayer))
 	} else if len(g.players) == 0 {
-		g.currentUserID = ""
-		g.message = ALLEXITMSG
-		g.lastWord = ""
-		g.usedWords = make(map[string]bool)
-		g.gameover = false
+		g.handleAllPlayersLeft()
+	}
+}
+
+// handleAllPlayersLeft 는 플레이어가 모두 나가면 로비로 돌아간다.
+// 관전자가 남아 있으면 플레이어 자리로 옮기고 그중에서 방장을 뽑아 방을 이어 간다.
+func (g *Game) handleAllPlayersLeft() {
+	g.currentUserID = ""
+	g.message = i18n.New(ALLEXITMSG)
+	g.lastWord = ""
+	g.usedWords = make(map[string]bool)
+	g.transition(PhaseEventReset)
+	g.promoteSpectators()
+	if len(g.players) > 0 {
+		g.makeNewHost()
 	}
 }
 
+// deleteRoom 은 플레이어와 관전자가 모두 나간 방을 매니저에서 지우고 닫는다.
 func (g *Game) deleteRoom() {
-	if len(g.players) == 0 {
-		log.Printf(DELETEROOMLOGMSG, g.RoomId)
+	if len(g.players) == 0 && len(g.spectators) == 0 {
+		logging.Info(g.logger, DELETEROOMLOGMSG)
 		g.manager.DeleteRoom(g.RoomId)
+		g.room.Stop()
 	}
 }
@@ -39,3 +39,23 @@ func TestRemoveUser(t *testing.T) {
 	assert.NotContains(t, g.players, user1, "Player list should not contain the removed user")
 	assert.Contains(t, g.players, user2, "Player list should still contain other users")
 }
+
+func TestLastPlayerLeavingKeepsRoomForSpectators(t *testing.T) {
+	g := SetupDefaultPlayers(WithPlayers(1))
+	player := g.players[0]
+	spectator := &User{ID: "1002", Name: "Bob", Tag: "7002"}
+	g.spectators = append(g.spectators, spectator)
+
+	g.removeUser(player)
+
+	_, exists := g.manager.GetRoom(g.RoomId)
+	assert.True(t, exists, "Room should stay open while spectators remain")
+	assert.Equal(t, []*User{spectator}, g.players, "Spectators should take the empty seats")
+	assert.Empty(t, g.spectators)
+	assert.Equal(t, spectator.ID, g.hostUserId, "A promoted spectator should become the host")
+
+	g.removeUser(spectator)
+
+	_, exists = g.manager.GetRoom(g.RoomId)
+	assert.False(t, exists, "Room should close once everyone has left")
+}
@@ -0,0 +1,182 @@
+package game
+
+import (
+	"errors"
+	"time"
+
+	"wordgame/internal/i18n"
+	"wordgame/internal/logging"
+	"wordgame/internal/metrics"
+)
+
+var ErrNotPlayer = errors.New("only players can get ready")
+
+// SetReady 는 로비에서 플레이어의 준비 상태를 바꾼다. 카운트다운 중에 준비를 풀면 시작을 취소한다.
+// 자동 시작을 켠 방이면 모두 준비했을 때 카운트다운을 시작한다.
+func (g *Game) SetReady(userID string, ready bool) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.setReady(userID, ready)
+}
+
+func (g *Game) handleToggleReady(user *User) {
+	g.mu.Lock()
+	err := g.setReady(user.ID, !g.ready[user.ID])
+	g.mu.Unlock()
+	if err != nil {
+		g.sendNotice(user, READYERRORCODE, i18n.New(readyErrorMessage(err)))
+		return
+	}
+	g.broadcastGameState()
+}
+
+func readyErrorMessage(err error) string {
+	if errors.Is(err, ErrNotPlayer) {
+		return READYNOTPLAYERMSG
+	}
+	return GAMEALREADYSTARTEDMSG
+}
+
+// 아래 함수들은 모두 잠금을 호출자가 관리한다.
+
+func (g *Game) setReady(userID string, ready bool) error {
+	countdown := g.state.Phase() == PhaseCountdown
+	if g.isStarted() && !(countdown && !ready) {
+		return ErrGameInProgress
+	}
+	if !g.isPlayer(userID) {
+		return ErrNotPlayer
+	}
+	user := g.findUser(userID)
+	if ready {
+		g.ready[userID] = true
+		g.message = g.playerMessage(PLAYERREADYMSG, user)
+	} else {
+		delete(g.ready, userID)
+		g.message = g.playerMessage(PLAYERNOTREADYMSG, user)
+	}
+	logging.Info(g.logger, READYLOGMSG, logging.UserIDKey, userID, "ready", ready)
+
+	if countdown {
+		g.cancelCountdown(user)
+	} else if ready {
+		g.autoStart()
+	}
+	return nil
+}
+
+// isReady 는 플레이어가 준비했는지 본다. 봇은 늘 준비되어 있다.
+func (g *Game) isReady(user *User) bool {
+	return user.IsBot() || g.ready[user.ID]
+}
+
+func (g *Game) readyCount() int {
+	count := 0
+	for _, p := range g.players {
+		if g.isReady(p) {
+			count++
+		}
+	}
+	return count
+}
+
+// readyNeeded 는 시작하는 데 필요한 준비 인원이다. 정족수 비율에서 올림한다.
+func (g *Game) readyNeeded() int {
+	needed := (len(g.players)*g.settings.ReadyQuorum + 99) / 100
+	if needed < 1 {
+		return 1
+	}
+	return needed
+}
+
+// autoStart 는 자동 시작을 켠 방에서 모든 플레이어가 준비했으면 카운트다운을 시작한다.
+func (g *Game) autoStart() {
+	if !g.settings.AutoStart || g.isStarted() || len(g.players) < MinPlayersToStart {
+		return
+	}
+	if g.readyCount() < len(g.players) {
+		return
+	}
+	if g.settings.TeamMode && g.prepareTeams() != nil {
+		return
+	}
+	if !g.hasPlayableDictionary() {
+		g.message = i18n.New(DICTIONARYEMPTYMSG, "language", g.settings.Language)
+		return
+	}
+	g.beginCountdown()
+}
+
+// beginCountdown 은 로비를 떠나 카운트다운을 시작한다. 카운트다운이 0초면 바로 첫 라운드를 연다.
+func (g *Game) beginCountdown() {
+	if !g.transition(PhaseEventStart) {
+		return
+	}
+	g.countdown = g.settings.CountdownSeconds
+	if g.countdown == 0 {
+		g.launchGame()
+		return
+	}
+	g.message = i18n.New(COUNTDOWNMSG, "seconds", g.countdown)
+	logging.Info(g.logger, COUNTDOWNLOGMSG, "seconds", g.countdown)
+	g.scheduleCountdownTick()
+}
+
+func (g *Game) scheduleCountdownTick() {
+	g.countdownRun++
+	run := g.countdownRun
+	g.countdownTimer = g.clock.AfterFunc(CountdownTick, func() {
+		g.countdownTick(run)
+	})
+}
+
+// countdownTick 은 타이머 고루틴에서 불린다. 1초마다 남은 시간을 알리고 0이 되면 첫 라운드를 연다.
+// 이미 취소되었거나 다시 시작된 카운트다운의 타이머면 아무것도 하지 않는다.
+func (g *Game) countdownTick(run int) {
+	g.mu.Lock()
+	if run != g.countdownRun || g.state.Phase() != PhaseCountdown {
+		g.mu.Unlock()
+		return
+	}
+	g.countdown--
+	if g.countdown > 0 {
+		g.message = i18n.New(COUNTDOWNMSG, "seconds", g.countdown)
+		g.scheduleCountdownTick()
+	} else {
+		g.countdownTimer = nil
+		g.launchGame()
+	}
+	g.mu.Unlock()
+	g.broadcastGameState()
+}
+
+// launchGame 은 카운트다운이 끝난 뒤 목숨과 점수를 채우고 첫 라운드를 연다.
+func (g *Game) launchGame() {
+	g.countdown = 0
+	g.resetLives()
+	g.resetScores()
+	g.startReplay()
+	g.startNewRound()
+	g.finishedAt = time.Time{}
+	metrics.GamesStarted.Inc()
+}
+
+// cancelCountdown 은 카운트다운 중이면 멈추고 로비로 돌아간다. user 는 취소하게 만든 플레이어다.
+func (g *Game) cancelCountdown(user *User) {
+	if g.state.Phase() != PhaseCountdown {
+		return
+	}
+	g.stopCountdownTimer()
+	g.transition(PhaseEventCancel)
+	g.message = g.playerMessage(COUNTDOWNCANCELMSG, user)
+	logging.Info(g.logger, COUNTDOWNCANCELLOGMSG, logging.UserIDKey, user.ID)
+}
+
+func (g *Game) stopCountdownTimer() {
+	if g.countdownTimer != nil {
+		g.countdownTimer.Stop()
+		g.countdownTimer = nil
+	}
+	g.countdownRun++
+	g.countdown = 0
+}
@@ -0,0 +1,195 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startReadyGame 은 모든 플레이어를 준비시키고 방장이 시작한 뒤 카운트다운을 끝까지 흘린다.
+func startReadyGame(g *Game, host *User) {
+	readyAll(g)
+	g.startGame(host)
+	FakeClock(g).Advance(CountdownTick * DefaultCountdownSeconds)
+}
+
+func TestStartGameWaitsForReadyPlayers(t *testing.T) {
+	testCases := []struct {
+		name    string
+		quorum  int
+		ready   []int
+		started bool
+		needed  int
+	}{
+		{name: "everyone must be ready by default", quorum: 100, ready: []int{1}, started: false, needed: 3},
+		{name: "everyone ready", quorum: 100, ready: []int{1, 2}, started: true},
+		{name: "quorum rounds up", quorum: 50, ready: nil, started: false, needed: 2},
+		{name: "quorum met", quorum: 50, ready: []int{2}, started: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := SetupDefaultPlayers()
+			settings := DefaultRoomSettings()
+			settings.ReadyQuorum = tc.quorum
+			g.ApplySettings(settings)
+			for _, i := range tc.ready {
+				require.NoError(t, g.SetReady(g.players[i].ID, true))
+			}
+
+			g.startGame(g.players[0])
+
+			if tc.started {
+				assert.Equal(t, PhaseCountdown, g.state.Phase())
+				return
+			}
+			assert.Equal(t, PhaseLobby, g.state.Phase())
+			assert.Equal(t, PLAYERSNOTREADYMSG, g.message.Code)
+			assert.Equal(t, len(tc.ready)+1, g.message.Params["ready"], "Pressing start should make the host ready")
+			assert.Equal(t, tc.needed, g.message.Params["needed"])
+		})
+	}
+}
+
+func TestBotsAreAlwaysReady(t *testing.T) {
+	g := SetupDefaultPlayers()
+	g.players[1].BotDifficulty = BotDifficultyEasy
+	g.players[2].BotDifficulty = BotDifficultyEasy
+
+	g.startGame(g.players[0])
+
+	assert.Equal(t, PhaseCountdown, g.state.Phase())
+}
+
+func TestCountdownStartsFirstRound(t *testing.T) {
+	g := SetupDefaultPlayers()
+	readyAll(g)
+
+	g.startGame(g.players[0])
+
+	assert.Equal(t, PhaseCountdown, g.state.Phase())
+	assert.Equal(t, COUNTDOWNMSG, g.message.Code)
+	assert.Equal(t, DefaultCountdownSeconds, g.message.Params["seconds"])
+	assert.Empty(t, g.lastWord, "No word should be chosen before the countdown ends")
+
+	FakeClock(g).Advance(CountdownTick)
+	assert.Equal(t, DefaultCountdownSeconds-1, g.countdown)
+	assert.Equal(t, DefaultCountdownSeconds-1, g.message.Params["seconds"])
+
+	FakeClock(g).Advance(CountdownTick * (DefaultCountdownSeconds - 1))
+	assert.Equal(t, PhaseInTurn, g.state.Phase())
+	assert.Equal(t, STARTMSG, g.message.Code)
+	assert.Zero(t, g.countdown)
+	assert.NotEmpty(t, g.lastWord)
+}
+
+func TestZeroCountdownStartsImmediately(t *testing.T) {
+	g := SetupDefaultPlayers()
+	settings := DefaultRoomSettings()
+	settings.CountdownSeconds = 0
+	g.ApplySettings(settings)
+	readyAll(g)
+
+	g.startGame(g.players[0])
+
+	assert.Equal(t, PhaseInTurn, g.state.Phase())
+	assert.Zero(t, FakeClock(g).Pending())
+}
+
+func TestCountdownCancelled(t *testing.T) {
+	testCases := []struct {
+		name   string
+		cancel func(g *Game, user *User)
+	}{
+		{
+			name:   "player leaves",
+			cancel: func(g *Game, user *User) { g.removeUser(user) },
+		},
+		{
+			name:   "player is no longer ready",
+			cancel: func(g *Game, user *User) { require.NoError(t, g.SetReady(user.ID, false)) },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := SetupDefaultPlayers()
+			readyAll(g)
+			g.startGame(g.players[0])
+			FakeClock(g).Advance(CountdownTick)
+			user := g.players[2]
+
+			tc.cancel(g, user)
+
+			assert.Equal(t, PhaseLobby, g.state.Phase())
+			assert.Equal(t, COUNTDOWNCANCELMSG, g.message.Code)
+			assert.Equal(t, user.Tag, g.message.Params["playerId"])
+			assert.Zero(t, g.countdown)
+
+			FakeClock(g).Advance(CountdownTick * DefaultCountdownSeconds)
+			assert.Equal(t, PhaseLobby, g.state.Phase(), "Cancelled countdown should not start the game")
+		})
+	}
+}
+
+func TestSpectatorLeavingKeepsCountdown(t *testing.T) {
+	g := SetupDefaultPlayers()
+	spectator := &User{ID: "2001", Name: "Dave"}
+	g.spectators = append(g.spectators, spectator)
+	readyAll(g)
+	g.startGame(g.players[0])
+
+	g.removeUser(spectator)
+
+	assert.Equal(t, PhaseCountdown, g.state.Phase())
+}
+
+func TestAutoStart(t *testing.T) {
+	for _, autoStart := range []bool{true, false} {
+		g := SetupDefaultPlayers()
+		settings := DefaultRoomSettings()
+		settings.AutoStart = autoStart
+		g.ApplySettings(settings)
+
+		for _, p := range g.players {
+			require.NoError(t, g.SetReady(p.ID, true))
+		}
+
+		if autoStart {
+			assert.Equal(t, PhaseCountdown, g.state.Phase(), "Game should start once everyone is ready")
+		} else {
+			assert.Equal(t, PhaseLobby, g.state.Phase(), "Game should wait for the host without auto start")
+			assert.Equal(t, PLAYERREADYMSG, g.message.Code)
+		}
+	}
+}
+
+func TestSetReadyErrors(t *testing.T) {
+	g := SetupDefaultPlayers()
+	spectator := &User{ID: "2001", Name: "Dave"}
+	g.spectators = append(g.spectators, spectator)
+
+	assert.ErrorIs(t, g.SetReady(spectator.ID, true), ErrNotPlayer)
+
+	startReadyGame(g, g.players[0])
+	assert.ErrorIs(t, g.SetReady(g.players[1].ID, false), ErrGameInProgress, "Ready cannot change once the game is running")
+}
+
+func TestResetClearsReady(t *testing.T) {
+	g := SetupDefaultPlayers()
+	startReadyGame(g, g.players[0])
+
+	g.mu.Lock()
+	g.reset()
+	g.mu.Unlock()
+
+	assert.Empty(t, g.ready, "Players should get ready again for the next game")
+	assert.False(t, g.makePlayerInfo(g.players[1]).Ready)
+}
+
+func readyAll(g *Game) {
+	for _, p := range g.players {
+		_ = g.SetReady(p.ID, true)
+	}
+}
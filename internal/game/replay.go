@@ -0,0 +1,216 @@
+package game
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"wordgame/internal/i18n"
+	"wordgame/internal/logging"
+	"wordgame/internal/store"
+
+	"github.com/gofiber/contrib/websocket"
+)
+
+const (
+	ReplayEventJoin      = "join"
+	ReplayEventSpectate  = "spectate"
+	ReplayEventLeave     = "leave"
+	ReplayEventStart     = "start"
+	ReplayEventSubmit    = "submit"
+	ReplayEventEliminate = "eliminate"
+	ReplayEventEnd       = "end"
+	ReplayEventState     = "state" // 그 시점에 접속자에게 보낸 상태. 다시 보기는 이 이벤트만 보낸다.
+
+	ReplayOutcomeAccepted = "accepted"
+)
+
+// ReplayEvent 는 게임 기록의 이벤트 하나다. 거절된 단어의 Outcome 은 거절 메시지 코드다.
+// 기록은 누구나 내려받을 수 있으므로 UserID 에는 세션 ID 가 아닌 공개 ID(태그)를 남긴다.
+type ReplayEvent struct {
+	Seq     int                        `json:"seq"`
+	At      time.Time                  `json:"at"`
+	Type    string                     `json:"type"`
+	UserID  string                     `json:"userId,omitempty"`
+	Name    string                     `json:"name,omitempty"`
+	Word    string                     `json:"word,omitempty"`
+	Outcome string                     `json:"outcome,omitempty"`
+	Message *i18n.Message              `json:"message,omitempty"`
+	State   map[string]json.RawMessage `json:"state,omitempty"` // 언어별 상태
+}
+
+type replayRecorder struct {
+	startedAt time.Time
+	events    []ReplayEvent
+	states    int                        // events 에 들어간 상태 이벤트 수
+	lastState map[string]json.RawMessage // 마지막으로 받은 상태
+	tail      *ReplayEvent               // MaxReplayStates 를 넘은 뒤의 마지막 상태. 저장할 때 붙인다.
+}
+
+// 아래 함수들은 saveReplay 를 빼고 모두 잠금을 호출자가 관리한다.
+
+// startReplay 는 게임을 시작할 때 새 기록을 열고 지금 방에 있는 사람을 남긴다.
+func (g *Game) startReplay() {
+	g.replay = &replayRecorder{startedAt: g.clock.Now()}
+	for _, p := range g.players {
+		g.recordUser(ReplayEventJoin, p)
+	}
+	for _, s := range g.spectators {
+		g.recordUser(ReplayEventSpectate, s)
+	}
+}
+
+func (g *Game) recordReplay(event ReplayEvent) {
+	if g.replay == nil {
+		return
+	}
+	event.Seq = len(g.replay.events) + 1
+	event.At = g.clock.Now()
+	g.replay.events = append(g.replay.events, event)
+}
+
+func (g *Game) recordUser(eventType string, user *User) {
+	g.recordReplay(ReplayEvent{Type: eventType, UserID: user.Tag, Name: g.makeNameToDisplay(user.Tag, user.Name)})
+}
+
+// recordState 는 접속자에게 보낸 상태를 남긴다. 바로 앞과 같은 상태는 건너뛰고,
+// MaxReplayStates 를 넘으면 마지막 상태 하나만 들고 있다가 saveReplay 가 붙인다.
+func (g *Game) recordState(states LocalizedMessage) {
+	if g.replay == nil {
+		return
+	}
+	state := make(map[string]json.RawMessage, len(states))
+	for locale, bytes := range states {
+		state[locale] = bytes
+	}
+	r := g.replay
+	if sameState(r.lastState, state) {
+		return
+	}
+	r.lastState = state
+	if r.states >= MaxReplayStates {
+		r.tail = &ReplayEvent{Type: ReplayEventState, At: g.clock.Now(), State: state}
+		return
+	}
+	r.states++
+	g.recordReplay(ReplayEvent{Type: ReplayEventState, State: state})
+}
+
+func sameState(a, b map[string]json.RawMessage) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for locale, state := range a {
+		if !bytes.Equal(state, b[locale]) {
+			return false
+		}
+	}
+	return true
+}
+
+// rejectWord 는 거절된 단어를 지표와 게임 기록에 남긴다. 단어를 낸 사람은 지금 차례인 플레이어다.
+func (g *Game) rejectWord(word, reason string) {
+	recordRejection(reason)
+	g.recordReplay(ReplayEvent{Type: ReplayEventSubmit, UserID: g.publicID(g.currentUserID), Word: word, Outcome: reason})
+}
+
+// saveReplay 는 끝난 게임의 기록을 저장하고, 상태에 보여줄 기록 번호를 남긴다.
+func (g *Game) saveReplay() {
+	g.mu.Lock()
+	replay := g.replay
+	g.replay = nil
+	g.mu.Unlock()
+	if replay == nil {
+		return
+	}
+
+	if replay.tail != nil {
+		replay.tail.Seq = len(replay.events) + 1
+		replay.events = append(replay.events, *replay.tail)
+	}
+	bytes, err := json.Marshal(replay.events)
+	if err != nil {
+		logging.Error(g.logger, MARSHALERROR, logging.ErrorKey, err)
+		return
+	}
+	record := &store.GameReplay{
+		RoomID:    g.RoomId,
+		RoomName:  g.RoomName,
+		Events:    string(bytes),
+		StartedAt: replay.startedAt,
+		EndedAt:   g.clock.Now(),
+	}
+	if err := g.store.SaveGameReplay(record); err != nil {
+		logging.Error(g.logger, SAVEREPLAYERRORLOGMSG, logging.ErrorKey, err)
+		return
+	}
+	g.mu.Lock()
+	g.replayID = record.ID
+	g.mu.Unlock()
+	logging.Info(g.logger, SAVEREPLAYLOGMSG, "replay_id", record.ID, "events", len(replay.events))
+}
+
+// StreamReplay 는 기록된 상태를 원래 간격의 1/speed 로 보내고 연결을 닫는다.
+// 라이브 게임과 같은 형식이라 room.html 이 그대로 그릴 수 있다. 연결이 끊기면 기다리지 않고 멈춘다.
+func StreamReplay(conn Conn, events []ReplayEvent, locale string, speed float64) {
+	gone := watchDisconnect(conn)
+	defer func() {
+		_ = conn.Close()
+		<-gone
+	}()
+
+	var prev time.Time
+	for _, event := range events {
+		if event.Type != ReplayEventState {
+			continue
+		}
+		if !prev.IsZero() {
+			wait := time.NewTimer(time.Duration(float64(event.At.Sub(prev)) / speed))
+			select {
+			case <-wait.C:
+			case <-gone:
+				wait.Stop()
+				return
+			}
+		}
+		prev = event.At
+
+		state, ok := event.State[locale]
+		if !ok {
+			state = event.State[i18n.DefaultLocale]
+		}
+		if err := conn.WriteMessage(websocket.TextMessage, state); err != nil {
+			return
+		}
+	}
+	_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+}
+
+// watchDisconnect 는 다시 보기 중에 클라이언트가 보내는 메시지를 버리며 읽다가,
+// 연결이 끊기면 돌려준 채널을 닫는다.
+func watchDisconnect(conn Conn) <-chan struct{} {
+	gone := make(chan struct{})
+	go func() {
+		defer close(gone)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+	return gone
+}
+
+// ReplaySpeed 는 요청한 재생 속도를 허용 범위로 맞춘다. 0 이하면 원래 속도다.
+func ReplaySpeed(speed float64) float64 {
+	if speed <= 0 {
+		return 1
+	}
+	if speed < MinReplaySpeed {
+		return MinReplaySpeed
+	}
+	if speed > MaxReplaySpeed {
+		return MaxReplaySpeed
+	}
+	return speed
+}
@@ -0,0 +1,137 @@
+package game
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"wordgame/internal/i18n"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func replayEventTypes(g *Game) []string {
+	var types []string
+	for _, e := range g.replay.events {
+		if e.Type != ReplayEventState {
+			types = append(types, e.Type)
+		}
+	}
+	return types
+}
+
+func TestReplayRecordsSubmissions(t *testing.T) {
+	g := SetupStartedGame()
+	playTurns(t, g, "주사")
+	loser := currentPlayer(g)
+
+	g.handlePlay(loser, "기차")
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	assert.Equal(t, []string{
+		ReplayEventJoin, ReplayEventJoin, ReplayEventJoin, ReplayEventStart,
+		ReplayEventSubmit, ReplayEventSubmit, ReplayEventEliminate, ReplayEventStart,
+	}, replayEventTypes(g), "Elimination should start a new round")
+
+	var submits []ReplayEvent
+	for i, e := range g.replay.events {
+		assert.Equal(t, i+1, e.Seq, "Events should be numbered in order")
+		assert.NotContains(t, []string{"1001", "1002", "1003"}, e.UserID, "Session IDs should never be recorded")
+		if e.Type == ReplayEventSubmit {
+			submits = append(submits, e)
+		}
+	}
+	assert.Equal(t, ReplayOutcomeAccepted, submits[0].Outcome)
+	assert.Equal(t, loser.Tag, submits[1].UserID, "Replays are public, so they should only hold public IDs")
+	assert.Equal(t, WORDMISMATCHMSG, submits[1].Outcome, "Rejected words should keep the rejection code")
+}
+
+func TestReplayRecordsBroadcastState(t *testing.T) {
+	g := SetupStartedGame()
+
+	g.broadcastGameState()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	last := g.replay.events[len(g.replay.events)-1]
+	assert.Equal(t, ReplayEventState, last.Type)
+	assert.Contains(t, string(last.State["ko"]), `"lastWord":"자동차경주"`, "State events should hold what clients received")
+	assert.Contains(t, last.State, "en", "State events should be kept for every locale")
+}
+
+func TestReplayStatesAreCompacted(t *testing.T) {
+	g := SetupStartedGame()
+	stateEvents := func(events []ReplayEvent) []ReplayEvent {
+		var states []ReplayEvent
+		for _, e := range events {
+			if e.Type == ReplayEventState {
+				states = append(states, e)
+			}
+		}
+		return states
+	}
+	localized := func(n int) LocalizedMessage {
+		return LocalizedMessage{i18n.DefaultLocale: json.RawMessage(strconv.Itoa(n))}
+	}
+
+	g.mu.Lock()
+	recorded := len(stateEvents(g.replay.events))
+	g.recordState(localized(0))
+	g.recordState(localized(0))
+	assert.Len(t, stateEvents(g.replay.events), recorded+1, "Unchanged state should not be recorded again")
+
+	for n := 1; n <= MaxReplayStates+1; n++ {
+		g.recordState(localized(n))
+	}
+	assert.Len(t, stateEvents(g.replay.events), MaxReplayStates, "State events should be capped")
+	g.mu.Unlock()
+
+	g.saveReplay()
+
+	var saved []ReplayEvent
+	assert.NoError(t, json.Unmarshal([]byte(g.store.(*MemoryStore).Replays()[0].Events), &saved))
+	states := stateEvents(saved)
+	assert.Len(t, states, MaxReplayStates+1, "The last state should be kept past the cap")
+	assert.Equal(t, strconv.Itoa(MaxReplayStates+1), string(states[len(states)-1].State[i18n.DefaultLocale]), "Replay should end on the final state")
+}
+
+func TestReplayNotRecordedOutsideGame(t *testing.T) {
+	g := SetupTestGame()
+
+	assert.NoError(t, g.addUser(&User{ID: "1001", Name: "Alice"}))
+
+	assert.Nil(t, g.replay, "Lobby activity should not be recorded")
+}
+
+func TestReplaySpeed(t *testing.T) {
+	assert.Equal(t, 1.0, ReplaySpeed(0))
+	assert.Equal(t, 2.0, ReplaySpeed(2))
+	assert.Equal(t, MinReplaySpeed, ReplaySpeed(0.01))
+	assert.Equal(t, MaxReplaySpeed, ReplaySpeed(1000))
+}
+
+func TestStreamReplayStopsOnDisconnect(t *testing.T) {
+	state := map[string]json.RawMessage{i18n.DefaultLocale: json.RawMessage(`{}`)}
+	events := []ReplayEvent{
+		{Type: ReplayEventState, At: TestStartTime, State: state},
+		{Type: ReplayEventState, At: TestStartTime.Add(time.Hour), State: state},
+	}
+	conn := NewMemoryConn()
+	done := make(chan struct{})
+	go func() {
+		StreamReplay(conn, events, i18n.DefaultLocale, 1)
+		close(done)
+	}()
+
+	_, err := conn.Next(time.Second)
+	assert.NoError(t, err, "First state should be sent right away")
+	conn.Close()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Replay should stop when the viewer disconnects")
+	}
+}
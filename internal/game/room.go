@@ -1,37 +1,87 @@
 package game
 
 import (
-	"log"
+	"log/slog"
 	"sync"
+	"time"
+
+	"wordgame/internal/i18n"
+	"wordgame/internal/logging"
+	"wordgame/internal/metrics"
 
 	"github.com/gofiber/contrib/websocket"
 )
 
+// LocalizedMessage 는 같은 메시지를 언어별로 렌더링한 것이다.
+type LocalizedMessage map[string][]byte
+
+// For 는 locale 에 맞는 메시지를 고른다. 없으면 기본 언어를 쓴다.
+func (m LocalizedMessage) For(locale string) []byte {
+	if message, ok := m[locale]; ok {
+		return message
+	}
+	return m[i18n.DefaultLocale]
+}
+
 type Room struct {
 	clients    map[*User]bool
-	broadcast  chan []byte
+	broadcast  chan LocalizedMessage
 	register   chan *User
 	unregister chan *User
+	done       chan struct{}
+	stopOnce   sync.Once
 	mu         sync.RWMutex
+	logger     *slog.Logger
 }
 
-func NewRoom() *Room {
+func NewRoom(logger *slog.Logger) *Room {
 	return &Room{
 		clients:    make(map[*User]bool),
-		broadcast:  make(chan []byte),
+		broadcast:  make(chan LocalizedMessage),
 		register:   make(chan *User),
 		unregister: make(chan *User),
+		done:       make(chan struct{}),
+		logger:     logger,
 	}
 }
 
 func (r *Room) Run() {
-	for {
-		r.handleConnection()
+	for r.handleConnection() {
+	}
+}
+
+// Stop 은 Run 고루틴을 끝낸다. 그 뒤의 Register, Unregister, Broadcast 는 아무 일도 하지 않는다.
+func (r *Room) Stop() {
+	r.stopOnce.Do(func() {
+		close(r.done)
+	})
+}
+
+func (r *Room) Register(user *User) {
+	select {
+	case r.register <- user:
+	case <-r.done:
+	}
+}
+
+func (r *Room) Unregister(user *User) {
+	select {
+	case r.unregister <- user:
+	case <-r.done:
 	}
 }
 
-func (r *Room) handleConnection() {
+func (r *Room) Broadcast(message LocalizedMessage) {
 	select {
+	case r.broadcast <- message:
+	case <-r.done:
+	}
+}
+
+func (r *Room) handleConnection() bool {
+	select {
+	case <-r.done:
+		return false
 	case user := <-r.register:
 		r.handleRegister(user)
 	case user := <-r.unregister:
@@ -39,25 +89,32 @@ func (r *Room) handleConnection() {
 	case message := <-r.broadcast:
 		r.broadcastMessage(message)
 	}
+	return true
 }
 
 func (r *Room) handleRegister(user *User) {
 	r.mu.Lock()
 	r.clients[user] = true
 	r.mu.Unlock()
-	log.Printf("User %s joined the room.", user.Name)
+	logging.Debug(r.logger, USERJOINEDLOGMSG, logging.UserIDKey, user.ID)
 }
 
 func (r *Room) handleUnregister(user *User) {
 	r.mu.Lock()
 	if _, ok := r.clients[user]; ok {
 		delete(r.clients, user)
-		log.Printf("User %s left the room.", user.Name)
+		logging.Debug(r.logger, USERLEFTLOGMSG, logging.UserIDKey, user.ID)
 	}
 	r.mu.Unlock()
 }
 
-func (r *Room) broadcastMessage(message []byte) {
+func (r *Room) broadcastMessage(message LocalizedMessage) {
+	start := time.Now()
+	defer func() {
+		metrics.BroadcastSeconds.Observe(time.Since(start).Seconds())
+	}()
+	metrics.BroadcastMessageBytes.Observe(float64(len(message.For(i18n.DefaultLocale))))
+
 	r.mu.RLock()
 	clients := make([]*User, 0, len(r.clients))
 	for client := range r.clients {
@@ -66,10 +123,10 @@ func (r *Room) broadcastMessage(message []byte) {
 	r.mu.RUnlock()
 
 	for _, client := range clients {
-		if err := client.WriteMessage(websocket.TextMessage, message); err != nil {
-			log.Printf("Error broadcasting to %s: %v. Closing connection.", client.Name, err)
+		if err := client.WriteMessage(websocket.TextMessage, message.For(client.Locale)); err != nil {
+			logging.Warn(r.logger, BROADCASTERRORLOGMSG, logging.UserIDKey, client.ID, logging.ErrorKey, err)
 			client.Close()
-			r.unregister <- client
+			r.handleUnregister(client)
 		}
 	}
 }
@@ -1,36 +1,65 @@
 package game
 
 import (
-	"log"
+	"errors"
+	"log/slog"
 	"sync"
 
-	"wordgame/internal/random"
-	"wordgame/internal/store"
+	"wordgame/internal/clock"
+	"wordgame/internal/i18n"
+	"wordgame/internal/logging"
+	"wordgame/internal/metrics"
 )
 
+var ErrRoomCapacity = errors.New("no free room id")
+
 type RoomManager struct {
-	rooms map[int]*Game
-	random *random.Manager
+	rooms       map[int]*Game
+	random      Random
+	clock       clock.Clock
+	maintenance bool
+	limits      Limits
+	connections int
+	janitorStop chan struct{}
+	logger      *slog.Logger
 
 	mutex sync.RWMutex
 }
 
-func NewRoomManager(random *random.Manager) *RoomManager {
+func NewRoomManager(random Random, logger *slog.Logger) *RoomManager {
 	return &RoomManager{
-		rooms: make(map[int]*Game),
+		rooms:  make(map[int]*Game),
 		random: random,
+		clock:  clock.Real{},
+		limits: DefaultLimits(),
+		logger: logger,
 	}
 }
 
-func (rm *RoomManager) MakeRoom(name string, db *store.DBManager) *Game {
+// SetClock 은 이후에 만드는 방이 쓸 시계를 바꾼다. 테스트에서 시간을 직접 흘릴 때 쓴다.
+func (rm *RoomManager) SetClock(c clock.Clock) {
+	rm.mutex.Lock()
+	defer rm.mutex.Unlock()
+	rm.clock = c
+}
+
+func (rm *RoomManager) MakeRoom(name string, db Store) (*Game, error) {
 	rm.mutex.Lock()
 	defer rm.mutex.Unlock()
 
-	roomId := rm.generateRoomID()
+	if isLimitReached(len(rm.rooms), rm.limits.MaxRooms) {
+		return nil, ErrTooManyRooms
+	}
+
+	roomId, err := rm.generateRoomID()
+	if err != nil {
+		return nil, err
+	}
 	room := NewGame(name, roomId, rm, rm.random, db)
 	rm.rooms[roomId] = room
-	log.Printf("Room created: %d", roomId)
-	return room
+	metrics.ActiveRooms.Set(float64(len(rm.rooms)))
+	logging.Info(rm.logger, ROOMCREATEDLOGMSG, logging.RoomIDKey, roomId, "room_name", name)
+	return room, nil
 }
 
 func (rm *RoomManager) GetRoom(id int) (*Game, bool) {
@@ -42,28 +71,105 @@ func (rm *RoomManager) GetRoom(id int) (*Game, bool) {
 }
 
 func (rm *RoomManager) GetRooms() []map[string]any {
-	rm.mutex.RLock()
-	defer rm.mutex.RUnlock()
-
-	list := make([]map[string]any, 0, len(rm.rooms))
-	for id, game := range rm.rooms {
-		list = append(list, map[string]any{
-			"id":          id,
-			"roomName":    game.RoomName,
-			"playerCount": len(game.players),
-			"isStarted":   game.started,
-		})
+	rooms := rm.snapshotRooms()
+	list := make([]map[string]any, 0, len(rooms))
+	for _, game := range rooms {
+		list = append(list, game.roomSummary())
 	}
 	return list
 }
 
+// roomSummary 는 방 목록에 보일 방 정보를 게임 잠금을 잡고 읽는다.
+func (g *Game) roomSummary() map[string]any {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return map[string]any{
+		"id":          g.RoomId,
+		"roomName":    g.RoomName,
+		"playerCount": len(g.players),
+		"isStarted":   g.isStarted(),
+		"language":    g.settings.Language,
+	}
+}
+
 func (rm *RoomManager) DeleteRoom(id int) {
 	rm.mutex.Lock()
 	defer rm.mutex.Unlock()
 
 	delete(rm.rooms, id)
+	metrics.ActiveRooms.Set(float64(len(rm.rooms)))
+}
+
+func (rm *RoomManager) GetRoomDetails() []map[string]any {
+	list := make([]map[string]any, 0)
+	for _, game := range rm.snapshotRooms() {
+		list = append(list, game.AdminState())
+	}
+	return list
+}
+
+// CloseRoom 은 방의 접속자를 모두 내보내고 방을 삭제한다.
+func (rm *RoomManager) CloseRoom(id int, message i18n.Message) bool {
+	game, exists := rm.GetRoom(id)
+	if !exists {
+		return false
+	}
+	rm.DeleteRoom(id)
+	game.Close(message)
+	return true
 }
 
-func (rm *RoomManager) generateRoomID() int {
-	return rm.random.MakeRandomNumber(MINROOMIDIDENTIFIER, MAXROOMIDIDENTIFIER)
+func (rm *RoomManager) Announce(message string) int {
+	rooms := rm.snapshotRooms()
+	for _, game := range rooms {
+		game.Announce(message)
+	}
+	logging.Info(rm.logger, ANNOUNCELOGMSG, "rooms", len(rooms), "message", message)
+	return len(rooms)
+}
+
+func (rm *RoomManager) SetMaintenance(enabled bool) {
+	rm.mutex.Lock()
+	defer rm.mutex.Unlock()
+
+	rm.maintenance = enabled
+	logging.Info(rm.logger, MAINTENANCELOGMSG, "enabled", enabled)
+}
+
+func (rm *RoomManager) IsMaintenance() bool {
+	rm.mutex.RLock()
+	defer rm.mutex.RUnlock()
+
+	return rm.maintenance
+}
+
+// snapshotRooms 는 방 목록을 복사해 돌려준다. 각 방의 잠금은 RoomManager 잠금 밖에서 잡는다.
+func (rm *RoomManager) snapshotRooms() []*Game {
+	rm.mutex.RLock()
+	defer rm.mutex.RUnlock()
+
+	rooms := make([]*Game, 0, len(rm.rooms))
+	for _, game := range rm.rooms {
+		rooms = append(rooms, game)
+	}
+	return rooms
+}
+
+// generateRoomID 는 사용 중이지 않은 방 번호를 고른다. (잠금은 호출자가 관리)
+func (rm *RoomManager) generateRoomID() (int, error) {
+	for i := 0; i < MaxRoomIDAttempts; i++ {
+		id := rm.random.MakeRandomNumber(MINROOMIDIDENTIFIER, MAXROOMIDIDENTIFIER+1)
+		if _, exists := rm.rooms[id]; !exists {
+			return id, nil
+		}
+	}
+
+	// 방이 거의 다 찼으면 무작위로 찾기 어려우므로 빈 번호를 차례대로 찾는다.
+	for id := MINROOMIDIDENTIFIER; id <= MAXROOMIDIDENTIFIER; id++ {
+		if _, exists := rm.rooms[id]; !exists {
+			return id, nil
+		}
+	}
+	return 0, ErrRoomCapacity
 }
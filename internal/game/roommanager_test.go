@@ -1,8 +1,10 @@
 package game
 
 import (
+	"log/slog"
+
+	"wordgame/internal/i18n"
 	"wordgame/internal/random"
-	"wordgame/internal/store"
 
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -10,11 +12,12 @@ import (
 
 func TestMakeRoom(t *testing.T) {
 	randomManager := random.NewManager()
-	rm := NewRoomManager(randomManager)
-	dbMock := &store.DBManager{}
+	rm := NewRoomManager(randomManager, slog.Default())
+	memoryStore := NewMemoryStore()
 
 	roomName := "Test Room"
-	room := rm.MakeRoom(roomName, dbMock)
+	room, err := rm.MakeRoom(roomName, memoryStore)
+	assert.NoError(t, err, "MakeRoom should not fail")
 	assert.NotNil(t, room, "MakeRoom should return a non-nil room")
 	assert.Equal(t, roomName, room.RoomName, "Room name should match the provided name")
 
@@ -25,11 +28,13 @@ func TestMakeRoom(t *testing.T) {
 
 func TestGetRooms(t *testing.T) {
 	randomManager := random.NewManager()
-	rm := NewRoomManager(randomManager)
-	dbMock := &store.DBManager{}
+	rm := NewRoomManager(randomManager, slog.Default())
+	memoryStore := NewMemoryStore()
 
-	room1 := rm.MakeRoom("Room 1", dbMock)
-	room2 := rm.MakeRoom("Room 2", dbMock)
+	room1, err := rm.MakeRoom("Room 1", memoryStore)
+	assert.NoError(t, err, "MakeRoom should not fail")
+	room2, err := rm.MakeRoom("Room 2", memoryStore)
+	assert.NoError(t, err, "MakeRoom should not fail")
 	rooms := rm.GetRooms()
 
 	assert.Len(t, rooms, 2, "There should be 2 rooms in the manager")
@@ -47,14 +52,71 @@ func TestGetRooms(t *testing.T) {
 	}
 }
 
+func TestGetRoomsWhileSettingsChange(t *testing.T) {
+	g := SetupDefaultPlayers()
+	g.manager.rooms[g.RoomId] = g
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			g.ApplySettings(DefaultRoomSettings())
+		}
+	}()
+
+	for i := 0; i < 100; i++ {
+		rooms := g.manager.GetRooms()
+		assert.Equal(t, 3, rooms[0]["playerCount"])
+	}
+	<-done
+}
+
 func TestDeleteRoom(t *testing.T) {
 	randomManager := random.NewManager()
-	rm := NewRoomManager(randomManager)
-	dbMock := &store.DBManager{}
+	rm := NewRoomManager(randomManager, slog.Default())
+	memoryStore := NewMemoryStore()
 
-	room := rm.MakeRoom("Room to Delete", dbMock)
+	room, err := rm.MakeRoom("Room to Delete", memoryStore)
+	assert.NoError(t, err, "MakeRoom should not fail")
 	rm.DeleteRoom(room.RoomId)
 	_, exists := rm.GetRoom(room.RoomId)
 	
 	assert.False(t, exists, "Room should not exist after deletion")
 }
+
+func TestCloseRoom(t *testing.T) {
+	randomManager := random.NewManager()
+	rm := NewRoomManager(randomManager, slog.Default())
+	memoryStore := NewMemoryStore()
+
+	room, err := rm.MakeRoom("Room to Close", memoryStore)
+	assert.NoError(t, err, "MakeRoom should not fail")
+
+	assert.True(t, rm.CloseRoom(room.RoomId, i18n.New(ROOMCLOSEDMSG)), "CloseRoom should report the room was closed")
+	_, exists := rm.GetRoom(room.RoomId)
+	assert.False(t, exists, "Room should not exist after closing")
+	assert.False(t, rm.CloseRoom(room.RoomId, i18n.New(ROOMCLOSEDMSG)), "Closing a missing room should report false")
+}
+
+func TestMaintenance(t *testing.T) {
+	rm := NewRoomManager(random.NewManager(), slog.Default())
+
+	assert.False(t, rm.IsMaintenance(), "Maintenance mode should be off by default")
+	rm.SetMaintenance(true)
+	assert.True(t, rm.IsMaintenance(), "Maintenance mode should be on after enabling")
+}
+
+func TestMakeRoomUniqueIDs(t *testing.T) {
+	rm := NewRoomManager(random.NewManager(), slog.Default())
+	memoryStore := NewMemoryStore()
+	rm.limits.MaxRooms = 0 // 방 수 제한이 아니라 방 번호가 모자라는 경우를 본다.
+	for id := MINROOMIDIDENTIFIER; id < MAXROOMIDIDENTIFIER; id++ {
+		rm.rooms[id] = nil
+	}
+
+	room, err := rm.MakeRoom("Last Room", memoryStore)
+	assert.NoError(t, err, "The last free room ID should still be allocated")
+	assert.Equal(t, MAXROOMIDIDENTIFIER, room.RoomId, "The only free room ID should be chosen")
+
+	_, err = rm.MakeRoom("Overflow Room", memoryStore)
+	assert.ErrorIs(t, err, ErrRoomCapacity, "MakeRoom should fail when every room ID is taken")
+}
@@ -0,0 +1,218 @@
+package game
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	"wordgame/internal/i18n"
+	"wordgame/internal/logging"
+	"wordgame/internal/store"
+)
+
+// ScoreBreakdown 은 점수 모드에서 플레이어 한 명의 점수와 그 내역이다.
+type ScoreBreakdown struct {
+	UserID        string `json:"userId"` // 공개 ID(태그)
+	DisplayName   string `json:"displayName"`
+	Score         int    `json:"score"`
+	Words         int    `json:"words"`
+	Mistakes      int    `json:"mistakes"`
+	LengthPoints  int    `json:"lengthPoints"`
+	RarityPoints  int    `json:"rarityPoints"`
+	SpeedPoints   int    `json:"speedPoints"`
+	PenaltyPoints int    `json:"penaltyPoints"`
+	Left          bool   `json:"left,omitempty"` // 게임 도중 나간 플레이어
+}
+
+// 아래 함수들은 finishScoreGameByTime 을 빼고 모두 잠금을 호출자가 관리한다.
+
+// resetScores 는 점수 모드 게임을 시작할 때 점수를 비우고 시간 제한 타이머를 건다.
+func (g *Game) resetScores() {
+	g.stopScoreTimer()
+	g.scores = make(map[string]*ScoreBreakdown, len(g.players))
+	g.standings = nil
+	g.scoreTurns = 0
+	if !g.settings.ScoreMode {
+		return
+	}
+	if g.settings.ScoreMinutes > 0 {
+		g.scoreTimer = g.clock.AfterFunc(time.Duration(g.settings.ScoreMinutes)*time.Minute, g.finishScoreGameByTime)
+	}
+}
+
+func (g *Game) stopScoreTimer() {
+	if g.scoreTimer != nil {
+		g.scoreTimer.Stop()
+		g.scoreTimer = nil
+	}
+}
+
+func (g *Game) scoreOf(user *User) *ScoreBreakdown {
+	entry, ok := g.scores[user.ID]
+	if !ok {
+		entry = &ScoreBreakdown{UserID: user.Tag}
+		g.scores[user.ID] = entry
+	}
+	entry.DisplayName = g.makeNameToDisplay(user.Tag, user.Name)
+	return entry
+}
+
+func (g *Game) currentScore(user *User) int {
+	if entry, ok := g.scores[user.ID]; ok {
+		return entry.Score
+	}
+	return 0
+}
+
+// addWordScore 는 받아들여진 단어에 길이, 희귀도, 응답 속도 점수를 준다.
+func (g *Game) addWordScore(user *User, word string) {
+	entry := g.scoreOf(user)
+	length := utf8.RuneCountInString(word) * PointsPerRune
+	rarity := g.rarityPoints(word)
+	speed := speedPoints(g.clock.Now().Sub(g.turnStartedAt))
+
+	entry.Words++
+	entry.LengthPoints += length
+	entry.RarityPoints += rarity
+	entry.SpeedPoints += speed
+	entry.Score += length + rarity + speed
+}
+
+// rarityPoints 는 단어 다음에 이어 낼 수 있는 사전 단어가 적을수록 점수를 더 준다.
+// 같은 차례에 낸 단어라도 다음 사람을 얼마나 몰아붙이는지에 따라 점수가 달라진다.
+func (g *Game) rarityPoints(word string) int {
+	count, ok := g.continuationCount(word)
+	if !ok {
+		return 0
+	}
+	switch {
+	case count <= RareWordThreshold:
+		return RareWordPoints
+	case count <= UncommonWordThreshold:
+		return UncommonWordPoints
+	default:
+		return 0
+	}
+}
+
+func speedPoints(elapsed time.Duration) int {
+	switch {
+	case elapsed <= FastAnswerTime:
+		return FastAnswerPoints
+	case elapsed <= NormalAnswerTime:
+		return NormalAnswerPoints
+	default:
+		return 0
+	}
+}
+
+// penalizeScore 는 틀린 플레이어의 점수를 깎고 차례를 넘긴다. 점수 모드에서는 탈락하지 않는다.
+func (g *Game) penalizeScore(user *User, reason i18n.Message) (bool, i18n.Message) {
+	entry := g.scoreOf(user)
+	entry.Mistakes++
+	entry.PenaltyPoints += MistakePenaltyPoints
+	entry.Score -= MistakePenaltyPoints
+
+	penalty := g.playerMessage(SCOREPENALTYMSG, user, "points", MistakePenaltyPoints, "reason", reason)
+	g.setNextPlayerTurn(user.ID)
+	g.message = i18n.Join(penalty, g.message)
+	return g.advanceScoreTurn()
+}
+
+// advanceScoreTurn 은 차례 하나가 끝났음을 기록하고, 정해진 라운드를 다 돌았으면 게임을 끝낸다.
+func (g *Game) advanceScoreTurn() (bool, i18n.Message) {
+	if !g.settings.ScoreMode {
+		return false, i18n.Message{}
+	}
+	g.turnStartedAt = g.clock.Now()
+	g.scoreTurns++
+	if g.settings.ScoreRounds > 0 && g.scoreTurns >= g.settings.ScoreRounds*len(g.players) {
+		return true, g.finishScoreGame()
+	}
+	return false, i18n.Message{}
+}
+
+// handleScoreLeft 는 점수 모드에서 나간 플레이어를 순위에 표시하고,
+// 남은 플레이어가 MinPlayersToStart 보다 적으면 게임을 끝낸다.
+func (g *Game) handleScoreLeft(user *User) (bool, i18n.Message) {
+	if !g.settings.ScoreMode || !g.inGame() {
+		return false, i18n.Message{}
+	}
+	g.scoreOf(user).Left = true
+	if len(g.players) == 0 || len(g.players) >= MinPlayersToStart {
+		return false, i18n.Message{}
+	}
+	return true, g.finishScoreGame()
+}
+
+// finishScoreGameByTime 은 시간 제한이 끝났을 때 타이머 고루틴에서 불린다.
+func (g *Game) finishScoreGameByTime() {
+	g.mu.Lock()
+	if !g.inGame() {
+		g.mu.Unlock()
+		return
+	}
+	msg := g.finishScoreGame()
+	g.mu.Unlock()
+	g.endGame(msg)
+}
+
+// finishScoreGame 은 최종 순위를 정하고 우승 메시지를 돌려준다. 나간 플레이어는 남은 플레이어 뒤에 둔다.
+func (g *Game) finishScoreGame() i18n.Message {
+	g.stopScoreTimer()
+	for _, p := range g.players {
+		g.scoreOf(p)
+	}
+
+	standings := make([]ScoreBreakdown, 0, len(g.scores))
+	for _, entry := range g.scores {
+		standings = append(standings, *entry)
+	}
+	sort.SliceStable(standings, func(i, j int) bool {
+		if standings[i].Left != standings[j].Left {
+			return !standings[i].Left
+		}
+		if standings[i].Score != standings[j].Score {
+			return standings[i].Score > standings[j].Score
+		}
+		return standings[i].Words > standings[j].Words
+	})
+	g.standings = standings
+	g.transition(PhaseEventFinish)
+
+	if len(standings) == 0 {
+		g.message = i18n.New(SCOREENDMSG)
+		return g.message
+	}
+	g.message = i18n.New(SCOREWINNERMSG, "player", standings[0].DisplayName, "playerId", standings[0].UserID, "score", standings[0].Score)
+	return g.message
+}
+
+// saveStandings 는 점수 모드의 최종 순위를 저장한다.
+func (g *Game) saveStandings() {
+	g.mu.Lock()
+	standings := g.standings
+	g.mu.Unlock()
+	if len(standings) == 0 {
+		return
+	}
+
+	bytes, err := json.Marshal(standings)
+	if err != nil {
+		logging.Error(g.logger, MARSHALERROR, logging.ErrorKey, err)
+		return
+	}
+	result := &store.GameResult{
+		RoomID:    g.RoomId,
+		RoomName:  g.RoomName,
+		Mode:      SCOREMODE,
+		Winner:    standings[0].DisplayName,
+		Standings: string(bytes),
+	}
+	if err := g.store.SaveGameResult(result); err != nil {
+		logging.Error(g.logger, SAVERESULTERRORLOGMSG, logging.ErrorKey, err)
+		return
+	}
+	logging.Info(g.logger, SAVERESULTLOGMSG, "result_id", result.ID)
+}
@@ -0,0 +1,119 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"wordgame/internal/i18n"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newScoreSettings(rounds int) RoomSettings {
+	settings := DefaultRoomSettings()
+	settings.ScoreMode = true
+	settings.ScoreRounds = rounds
+	return settings
+}
+
+func TestAddWordScore(t *testing.T) {
+	g := SetupStartedGame(WithSettings(newScoreSettings(5)))
+	player := currentPlayer(g)
+
+	g.addWordScore(player, "사과나무")
+
+	entry := g.scores[player.ID]
+	assert.Equal(t, 4*PointsPerRune, entry.LengthPoints, "Longer words should earn more points")
+	assert.Equal(t, FastAnswerPoints, entry.SpeedPoints, "Quick answers should earn a speed bonus")
+	assert.Equal(t, RareWordPoints, entry.RarityPoints, "Only one word follows \"무\"")
+	assert.Equal(t, entry.LengthPoints+entry.SpeedPoints+entry.RarityPoints, entry.Score)
+	assert.Equal(t, 1, entry.Words)
+}
+
+func TestRarityPointsFollowContinuations(t *testing.T) {
+	// "과일" 다음에는 "일기" 하나만 이어지고, "과자" 다음에는 "자" 단어가 많이 이어진다.
+	words := append([]string{"과자"}, TestWords...)
+	for i := 0; i <= RareWordThreshold; i++ {
+		words = append(words, "자"+string(rune('가'+i)))
+	}
+
+	testCases := []struct {
+		word   string
+		rarity int
+	}{
+		{word: "과일", rarity: RareWordPoints},
+		{word: "과자", rarity: UncommonWordPoints},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.word, func(t *testing.T) {
+			g := SetupStartedGame(WithSettings(newScoreSettings(5)), WithWords(words...))
+			playTurns(t, g, "주사", "사과")
+			player := currentPlayer(g)
+
+			g.handlePlay(player, tc.word)
+
+			g.mu.Lock()
+			defer g.mu.Unlock()
+			assert.Equal(t, tc.rarity, g.scores[player.ID].RarityPoints, "Words leaving fewer continuations should be rarer")
+		})
+	}
+}
+
+func TestSpeedPoints(t *testing.T) {
+	assert.Equal(t, FastAnswerPoints, speedPoints(time.Second))
+	assert.Equal(t, NormalAnswerPoints, speedPoints(8*time.Second))
+	assert.Equal(t, 0, speedPoints(time.Minute), "Slow answers should not get a bonus")
+}
+
+func TestPenalizeScore(t *testing.T) {
+	g := SetupStartedGame(WithSettings(newScoreSettings(5)))
+	player := currentPlayer(g)
+
+	finished, _ := g.eliminatePlayer(player, i18n.New(WORDNOTINDICTMSG))
+
+	assert.False(t, finished)
+	assert.Len(t, g.players, 3, "Nobody should be eliminated in score mode")
+	assert.Equal(t, -MistakePenaltyPoints, g.scores[player.ID].Score, "Mistakes should lose points")
+	assert.NotEqual(t, player.ID, g.currentUserID, "Turn should pass to the next player")
+}
+
+func TestScoreGameEndsAfterRounds(t *testing.T) {
+	g := SetupStartedGame(WithSettings(newScoreSettings(1)))
+	winner := currentPlayer(g)
+
+	playTurns(t, g, "주사")
+	finished, _ := g.penalizeScore(currentPlayer(g), i18n.New(WORDMISMATCHMSG))
+	assert.False(t, finished, "Game should continue until everyone played the rounds")
+
+	finished, msg := g.penalizeScore(currentPlayer(g), i18n.New(WORDMISMATCHMSG))
+	assert.True(t, finished, "Game should end after the last round")
+	assert.True(t, g.isGameOver())
+	assert.Equal(t, winner.Tag, msg.Params["playerId"], "Top scorer should be announced")
+	assert.Len(t, g.standings, 3, "Standings should include every player")
+	assert.Equal(t, winner.Tag, g.standings[0].UserID, "Standings should be sorted by score")
+	assert.Equal(t, 1, g.standings[1].Mistakes, "Standings should include the breakdown")
+}
+
+func TestScoreGameEndsWhenPlayersLeave(t *testing.T) {
+	g := SetupStartedGame(WithSettings(newScoreSettings(5)))
+	leader := currentPlayer(g)
+	playTurns(t, g, "주사")
+
+	g.removeUser(leader)
+	assert.False(t, g.isGameOver(), "Game should go on while enough players remain")
+
+	g.removeUser(currentPlayer(g))
+
+	assert.True(t, g.isGameOver(), "Game should end when too few players remain")
+	assert.Len(t, g.standings, 3)
+	assert.Equal(t, g.players[0].Tag, g.standings[0].UserID, "Only a remaining player can win")
+	assert.True(t, g.standings[1].Left, "Departed players should be marked in the standings")
+	assert.Equal(t, leader.Tag, g.standings[1].UserID, "Departed players should rank after the remaining ones")
+}
+
+func TestScoreModeDisabledInTeamMode(t *testing.T) {
+	settings := RoomSettings{TeamMode: true, ScoreMode: true}.normalized()
+
+	assert.False(t, settings.ScoreMode, "Team mode should not be combined with score mode")
+}
@@ -0,0 +1,110 @@
+package game
+
+import "wordgame/internal/store"
+
+// RoomSettings 는 방장이 방을 만들 때 정하는 규칙 설정이다.
+type RoomSettings struct {
+	BanDeadEndWords  bool   `json:"banDeadEndWords"`
+	ChainRule        string `json:"chainRule"`
+	Language         string `json:"language"`
+	TeamMode         bool   `json:"teamMode"`
+	TeamCount        int    `json:"teamCount"`
+	TeamLives        int    `json:"teamLives"`
+	PlayerLives      int    `json:"playerLives"` // 1이면 한 번 틀릴 때 바로 탈락한다.
+	ScoreMode        bool   `json:"scoreMode"`
+	ScoreRounds      int    `json:"scoreRounds"`      // 0이면 라운드 제한 없음
+	ScoreMinutes     int    `json:"scoreMinutes"`     // 0이면 시간 제한 없음
+	Hints            bool   `json:"hints"`            // 이어질 단어 수를 보여주고 힌트 요청을 받는다.
+	ReadyQuorum      int    `json:"readyQuorum"`      // 시작하려면 준비해야 하는 플레이어 비율(%)
+	AutoStart        bool   `json:"autoStart"`        // 모두 준비하면 방장을 기다리지 않고 시작한다.
+	CountdownSeconds int    `json:"countdownSeconds"` // 0이면 카운트다운 없이 바로 시작한다.
+}
+
+func DefaultRoomSettings() RoomSettings {
+	return RoomSettings{
+		BanDeadEndWords:  false,
+		ChainRule:        DefaultChainRule,
+		Language:         store.DefaultLanguage,
+		TeamMode:         false,
+		TeamCount:        DefaultTeamCount,
+		TeamLives:        DefaultTeamLives,
+		PlayerLives:      DefaultPlayerLives,
+		ScoreMode:        false,
+		ScoreRounds:      DefaultScoreRounds,
+		ScoreMinutes:     0,
+		Hints:            false,
+		ReadyQuorum:      DefaultReadyQuorum,
+		AutoStart:        false,
+		CountdownSeconds: DefaultCountdownSeconds,
+	}
+}
+
+// normalized 는 범위를 벗어난 값을 기본값이나 한도로 맞춘다.
+func (s RoomSettings) normalized() RoomSettings {
+	if !IsValidChainRule(s.ChainRule) {
+		s.ChainRule = DefaultChainRule
+	}
+	if !store.IsValidLanguage(s.Language) {
+		s.Language = store.DefaultLanguage
+	}
+	if s.TeamCount < MinTeamCount {
+		s.TeamCount = MinTeamCount
+	} else if s.TeamCount > MaxTeamCount {
+		s.TeamCount = MaxTeamCount
+	}
+	if s.TeamLives < 1 {
+		s.TeamLives = DefaultTeamLives
+	} else if s.TeamLives > MaxLives {
+		s.TeamLives = MaxLives
+	}
+	if s.PlayerLives < 1 {
+		s.PlayerLives = DefaultPlayerLives
+	} else if s.PlayerLives > MaxLives {
+		s.PlayerLives = MaxLives
+	}
+	if s.TeamMode {
+		s.ScoreMode = false // 팀 모드는 팀 목숨으로 승패를 가린다.
+	}
+	if s.Hints && s.TeamMode {
+		s.TeamLives = clamp(s.TeamLives, MinHintLives, MaxLives)
+	} else if s.Hints && !s.ScoreMode {
+		s.PlayerLives = clamp(s.PlayerLives, MinHintLives, MaxLives)
+	}
+	s.ScoreRounds = clamp(s.ScoreRounds, 0, MaxScoreRounds)
+	s.ScoreMinutes = clamp(s.ScoreMinutes, 0, MaxScoreMinutes)
+	if s.ScoreMode && s.ScoreRounds == 0 && s.ScoreMinutes == 0 {
+		s.ScoreRounds = DefaultScoreRounds
+	}
+	if s.ReadyQuorum < 1 {
+		s.ReadyQuorum = DefaultReadyQuorum
+	} else if s.ReadyQuorum > 100 {
+		s.ReadyQuorum = 100
+	}
+	s.CountdownSeconds = clamp(s.CountdownSeconds, 0, MaxCountdownSeconds)
+	return s
+}
+
+func clamp(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+func (g *Game) ApplySettings(settings RoomSettings) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.settings = settings.normalized()
+	for _, p := range g.players {
+		g.assignTeamOnJoin(p)
+	}
+}
+
+func (g *Game) Settings() RoomSettings {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.settings
+}
@@ -0,0 +1,134 @@
+package game
+
+import (
+	"sync"
+
+	"wordgame/internal/store"
+)
+
+// Dictionary 는 게임이 쓰는 사전 기능이다.
+// store.Dictionary 와 store.MemoryDictionary 가 구현한다.
+type Dictionary interface {
+	Language() *store.Language
+	Normalize(word string) string
+	IsWordInDB(word string) bool
+	GetRandomWordByLength(length int) (string, error)
+	WordCount() (int, bool)
+	CountWordsByKey(name string, key store.KeyFunc, value string) (int, bool)
+	WordsByKey(name string, key store.KeyFunc, value string) ([]string, bool)
+}
+
+// Store 는 게임이 쓰는 저장소다. 서버는 NewDBStore 로 DB 를, 테스트는 MemoryStore 를 넣는다.
+type Store interface {
+	Dictionary(language string) Dictionary
+	AddWordSuggestion(word, suggestedBy string, roomID int) (*store.WordSuggestion, error)
+	SaveGameResult(result *store.GameResult) error
+	SaveGameReplay(replay *store.GameReplay) error
+}
+
+type dbStore struct {
+	*store.DBManager
+}
+
+func NewDBStore(db *store.DBManager) Store {
+	return dbStore{DBManager: db}
+}
+
+func (s dbStore) Dictionary(language string) Dictionary {
+	return s.DBManager.Dictionary(language)
+}
+
+// MemoryStore 는 파일 없이 메모리에서만 동작하는 저장소다.
+// 저장한 요청, 결과, 기록은 같은 이름의 메서드로 꺼내 확인할 수 있다.
+type MemoryStore struct {
+	mu           sync.Mutex
+	dictionaries map[string]*store.MemoryDictionary
+	suggestions  []store.WordSuggestion
+	results      []store.GameResult
+	replays      []store.GameReplay
+}
+
+// NewMemoryStore 는 기본 언어 사전에 words 를 넣은 저장소를 만든다.
+func NewMemoryStore(words ...string) *MemoryStore {
+	s := &MemoryStore{dictionaries: make(map[string]*store.MemoryDictionary)}
+	s.SetWords(store.DefaultLanguage, words...)
+	return s
+}
+
+// SetWords 는 언어의 사전을 words 로 바꾼다.
+func (s *MemoryStore) SetWords(language string, words ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	code := store.LookupLanguage(language).Code
+	s.dictionaries[code] = store.NewMemoryDictionary(code, words)
+}
+
+func (s *MemoryStore) Dictionary(language string) Dictionary {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	code := store.LookupLanguage(language).Code
+	d, ok := s.dictionaries[code]
+	if !ok {
+		d = store.NewMemoryDictionary(code, nil)
+		s.dictionaries[code] = d
+	}
+	return d
+}
+
+func (s *MemoryStore) AddWordSuggestion(word, suggestedBy string, roomID int) (*store.WordSuggestion, error) {
+	word = store.NormalizeWord(word)
+	if s.Dictionary(store.DefaultLanguage).IsWordInDB(word) {
+		return nil, store.ErrSuggestionAlreadyInDict
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, suggestion := range s.suggestions {
+		if suggestion.Word == word && suggestion.Status == store.SuggestionStatusPending {
+			return nil, store.ErrSuggestionAlreadyExists
+		}
+	}
+	suggestion := store.WordSuggestion{
+		ID:          uint(len(s.suggestions) + 1),
+		Word:        word,
+		Status:      store.SuggestionStatusPending,
+		SuggestedBy: suggestedBy,
+		RoomID:      roomID,
+	}
+	s.suggestions = append(s.suggestions, suggestion)
+	return &suggestion, nil
+}
+
+func (s *MemoryStore) SaveGameResult(result *store.GameResult) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result.ID = uint(len(s.results) + 1)
+	s.results = append(s.results, *result)
+	return nil
+}
+
+func (s *MemoryStore) SaveGameReplay(replay *store.GameReplay) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	replay.ID = uint(len(s.replays) + 1)
+	s.replays = append(s.replays, *replay)
+	return nil
+}
+
+func (s *MemoryStore) Suggestions() []store.WordSuggestion {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]store.WordSuggestion(nil), s.suggestions...)
+}
+
+func (s *MemoryStore) Results() []store.GameResult {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]store.GameResult(nil), s.results...)
+}
+
+func (s *MemoryStore) Replays() []store.GameReplay {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]store.GameReplay(nil), s.replays...)
+}
@@ -0,0 +1,58 @@
+package game
+
+import (
+	"errors"
+
+	"wordgame/internal/i18n"
+	"wordgame/internal/logging"
+	"wordgame/internal/store"
+)
+
+// handleSuggest 는 사전에 없어 탈락한 단어를 사전 추가 요청으로 접수한다.
+// 탈락 사유가 된 단어만 제안할 수 있다.
+func (g *Game) handleSuggest(user *User, gameMessage GameMessage) {
+	word, ok := gameMessage.Payload.(string)
+	if !ok {
+		logging.Warn(g.logger, SUGGESTPAYLOADERROR, logging.UserIDKey, user.ID, "payload", gameMessage.Payload)
+		return
+	}
+	word = store.NormalizeWord(word)
+
+	g.mu.Lock()
+	rejected, exists := g.rejectedWords[user.ID]
+	g.mu.Unlock()
+	if !exists || word == "" || rejected != word {
+		g.sendNotice(user, SUGGESTIONNOTALLOWEDCODE, i18n.New(SUGGESTIONNOTALLOWEDMSG, "word", word))
+		return
+	}
+
+	_, err := g.store.AddWordSuggestion(word, user.Name, g.RoomId)
+	switch {
+	case err == nil:
+		g.forgetRejectedWord(user)
+		logging.Info(g.logger, SUGGESTIONLOGMSG, logging.UserIDKey, user.ID, "word", word)
+		g.sendNotice(user, SUGGESTIONRECEIVEDCODE, i18n.New(SUGGESTIONRECEIVEDMSG, "word", word))
+	case errors.Is(err, store.ErrSuggestionAlreadyExists), errors.Is(err, store.ErrSuggestionAlreadyInDict):
+		g.forgetRejectedWord(user)
+		g.sendNotice(user, SUGGESTIONDUPLICATECODE, i18n.New(SUGGESTIONDUPLICATEMSG, "word", word))
+	default:
+		logging.Error(g.logger, SUGGESTIONERRORLOGMSG, logging.UserIDKey, user.ID, logging.ErrorKey, err)
+		g.sendNotice(user, SUGGESTIONFAILEDCODE, i18n.New(SUGGESTIONFAILEDMSG, "word", word))
+	}
+}
+
+func (g *Game) rememberRejectedWord(user *User, word string) {
+	if g.settings.Language != store.DefaultLanguage {
+		return // 단어 추가 요청은 기본 언어 사전에만 반영된다.
+	}
+	if allowed, checkDict := g.isWordAllowedByList(word); !allowed || !checkDict {
+		return // 방 전용 단어 목록 때문에 거절된 단어는 사전 추가 대상이 아니다.
+	}
+	g.rejectedWords[user.ID] = store.NormalizeWord(word)
+}
+
+func (g *Game) forgetRejectedWord(user *User) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.rejectedWords, user.ID)
+}
@@ -0,0 +1,41 @@
+package game
+
+import (
+	"testing"
+
+	"wordgame/internal/store"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRememberRejectedWord(t *testing.T) {
+	g := SetupTestGame()
+	user := &User{ID: "1001", Name: "Alice"}
+
+	g.rememberRejectedWord(user, "없는-단어")
+
+	assert.Equal(t, "없는단어", g.rejectedWords[user.ID], "Rejected word should be stored normalized")
+
+	g.forgetRejectedWord(user)
+	assert.NotContains(t, g.rejectedWords, user.ID, "Rejected word should be forgotten")
+}
+
+func TestRememberRejectedWordIgnoresAllowList(t *testing.T) {
+	g := SetupTestGame()
+	wl, _ := NewCustomWordList(store.WordListModeAllow, []string{"김치"}, g.dictionary().Normalize)
+	assert.NoError(t, g.SetWordList(wl))
+	user := &User{ID: "1001", Name: "Alice"}
+
+	g.rememberRejectedWord(user, "사과")
+
+	assert.NotContains(t, g.rejectedWords, user.ID, "Words rejected by the room word list should not be suggestible")
+}
+
+func TestHandleSuggestWithoutRejection(t *testing.T) {
+	g := SetupTestGame()
+	user := &User{ID: "1001", Name: "Alice"}
+
+	g.handleSuggest(user, GameMessage{Type: SUGGESTJSONTYPE, Payload: "사과"})
+
+	assert.NotContains(t, g.rejectedWords, user.ID, "Nothing should be recorded for a word that was not rejected")
+}
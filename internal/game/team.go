@@ -0,0 +1,302 @@
+package game
+
+import (
+	"encoding/json"
+	"errors"
+
+	"wordgame/internal/i18n"
+	"wordgame/internal/logging"
+)
+
+var (
+	ErrTeamModeDisabled = errors.New("team mode is disabled")
+	ErrInvalidTeam      = errors.New("invalid team")
+	ErrNotHost          = errors.New("only the host can do this")
+	ErrTeamsNotReady    = errors.New("teams are not ready")
+)
+
+// TeamInfo 는 상태 브로드캐스트에 들어가는 팀 정보다.
+type TeamInfo struct {
+	ID      int      `json:"id"`
+	Name    string   `json:"name"`
+	Players []string `json:"players"`
+	Lives   int      `json:"lives"`
+	Score   int      `json:"score"`
+}
+
+type assignTeamPayload struct {
+	UserID string `json:"userId"` // 공개 ID(태그)
+	Team   int    `json:"team"`
+}
+
+// AssignTeam 은 방장이 로비에서 플레이어의 팀을 정한다. 플레이어는 공개 ID(태그)로 가리킨다.
+func (g *Game) AssignTeam(hostID, tag string, team int) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err := g.checkTeamEditable(hostID); err != nil {
+		return err
+	}
+	if team < 0 || team >= g.settings.TeamCount {
+		return ErrInvalidTeam
+	}
+	user := g.findUserByTag(tag)
+	if user == nil || !g.isPlayer(user.ID) {
+		return ErrUserNotFound
+	}
+	g.teams[user.ID] = team
+	logging.Info(g.logger, TEAMASSIGNLOGMSG, logging.UserIDKey, user.ID, "team", team)
+	return nil
+}
+
+// BalanceTeams 는 방장이 로비에서 플레이어를 팀에 고르게 다시 나눈다.
+func (g *Game) BalanceTeams(hostID string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err := g.checkTeamEditable(hostID); err != nil {
+		return err
+	}
+	g.balanceTeams()
+	return nil
+}
+
+func (g *Game) checkTeamEditable(hostID string) error {
+	if !g.settings.TeamMode {
+		return ErrTeamModeDisabled
+	}
+	if g.hostUserId != hostID {
+		return ErrNotHost
+	}
+	if g.isStarted() {
+		return ErrGameInProgress
+	}
+	return nil
+}
+
+func (g *Game) handleAssignTeam(user *User, gameMessage GameMessage) {
+	var payload assignTeamPayload
+	if err := decodePayload(gameMessage.Payload, &payload); err != nil {
+		logging.Warn(g.logger, TEAMPAYLOADERROR, logging.UserIDKey, user.ID, "payload", gameMessage.Payload)
+		return
+	}
+	if err := g.AssignTeam(user.ID, payload.UserID, payload.Team); err != nil {
+		g.sendNotice(user, TEAMERRORCODE, i18n.New(teamErrorMessage(err)))
+		return
+	}
+	g.broadcastGameState()
+}
+
+func (g *Game) handleBalanceTeams(user *User) {
+	if err := g.BalanceTeams(user.ID); err != nil {
+		g.sendNotice(user, TEAMERRORCODE, i18n.New(teamErrorMessage(err)))
+		return
+	}
+	g.broadcastGameState()
+}
+
+func teamErrorMessage(err error) string {
+	switch {
+	case errors.Is(err, ErrTeamModeDisabled):
+		return TEAMMODEDISABLEDMSG
+	case errors.Is(err, ErrNotHost):
+		return NOHOSTTEAMMSG
+	case errors.Is(err, ErrGameInProgress):
+		return GAMEALREADYSTARTEDMSG
+	case errors.Is(err, ErrUserNotFound):
+		return TEAMUSERNOTFOUNDMSG
+	default:
+		return INVALIDTEAMMSG
+	}
+}
+
+// decodePayload 는 map 으로 풀린 메시지 payload 를 구조체로 옮긴다.
+func decodePayload(payload any, v any) error {
+	bytes, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, v)
+}
+
+// 아래 함수들은 모두 잠금을 호출자가 관리한다.
+
+func (g *Game) assignTeamOnJoin(user *User) {
+	if !g.settings.TeamMode {
+		return
+	}
+	if _, ok := g.teams[user.ID]; !ok {
+		g.teams[user.ID] = g.smallestTeam()
+	}
+}
+
+func (g *Game) balanceTeams() {
+	g.teams = make(map[string]int, len(g.players))
+	for i, p := range g.players {
+		g.teams[p.ID] = i % g.settings.TeamCount
+	}
+}
+
+func (g *Game) smallestTeam() int {
+	counts := make([]int, g.settings.TeamCount)
+	for _, p := range g.players {
+		if team, ok := g.teams[p.ID]; ok && team < len(counts) {
+			counts[team]++
+		}
+	}
+	smallest := 0
+	for team, count := range counts {
+		if count < counts[smallest] {
+			smallest = team
+		}
+	}
+	return smallest
+}
+
+func (g *Game) teamMembers(team int) []*User {
+	members := make([]*User, 0)
+	for _, p := range g.players {
+		if t, ok := g.teams[p.ID]; ok && t == team {
+			members = append(members, p)
+		}
+	}
+	return members
+}
+
+// prepareTeams 는 게임을 시작하기 전에 팀이 없는 플레이어를 배정하고 목숨과 점수를 초기화한다.
+func (g *Game) prepareTeams() error {
+	for _, p := range g.players {
+		g.assignTeamOnJoin(p)
+	}
+
+	g.teamLives = make([]int, g.settings.TeamCount)
+	g.teamScores = make([]int, g.settings.TeamCount)
+	g.teamCursor = make([]int, g.settings.TeamCount)
+	for team := range g.teamLives {
+		g.teamLives[team] = g.settings.TeamLives
+	}
+	if len(g.activeTeams()) < MinTeamCount {
+		return ErrTeamsNotReady
+	}
+	return nil
+}
+
+// activeTeams 는 목숨이 남아 있고 플레이어가 있는 팀을 돌려준다.
+func (g *Game) activeTeams() []int {
+	active := make([]int, 0, len(g.teamLives))
+	for team, lives := range g.teamLives {
+		if lives > 0 && len(g.teamMembers(team)) > 0 {
+			active = append(active, team)
+		}
+	}
+	return active
+}
+
+// firstTeamTurn 은 무작위 팀의 첫 플레이어에게 차례를 준다.
+func (g *Game) firstTeamTurn() *User {
+	active := g.activeTeams()
+	g.currentTeam = active[g.random.MakeRandomNumber(0, len(active))]
+	return g.takeTeamTurn(g.currentTeam)
+}
+
+// setNextTeamTurn 은 다음 팀으로 차례를 넘긴다. 팀 안에서는 플레이어가 돌아가며 차례를 맡는다.
+func (g *Game) setNextTeamTurn() {
+	for step := 1; step <= len(g.teamLives); step++ {
+		team := (g.currentTeam + step) % len(g.teamLives)
+		if g.teamLives[team] <= 0 || len(g.teamMembers(team)) == 0 {
+			continue
+		}
+		g.currentTeam = team
+		next := g.takeTeamTurn(team)
+		g.message = g.playerMessage(CURRENTTURNMSG, next)
+		return
+	}
+	g.currentUserID = ""
+}
+
+func (g *Game) takeTeamTurn(team int) *User {
+	members := g.teamMembers(team)
+	next := members[g.teamCursor[team]%len(members)]
+	g.teamCursor[team]++
+	g.currentUserID = next.ID
+	return next
+}
+
+// penalizeTeam 은 틀린 플레이어의 팀 목숨을 하나 줄이고 다음 팀으로 차례를 넘긴다.
+func (g *Game) penalizeTeam(user *User, reason i18n.Message) (bool, i18n.Message) {
+	team, ok := g.teams[user.ID]
+	if !ok || team >= len(g.teamLives) {
+		return false, i18n.Message{}
+	}
+	g.teamLives[team]--
+	logging.Info(g.logger, TEAMPENALTYLOGMSG, logging.UserIDKey, user.ID, "team", team, "lives", g.teamLives[team])
+
+	penalty := teamMessage(TEAMLIFELOSTMSG, team, "lives", g.teamLives[team], "reason", reason)
+	if g.teamLives[team] <= 0 {
+		penalty = teamMessage(TEAMELIMINATEDMSG, team, "reason", reason)
+	}
+
+	if winner, msg := g.handleWinnnerCheck(); winner {
+		return true, msg
+	}
+	g.setNextTeamTurn()
+	g.message = i18n.Join(penalty, g.message)
+	return false, i18n.Message{}
+}
+
+// handleTeamLeft 는 플레이어가 나간 뒤 팀 배정을 지우고, 게임 중이면 남은 팀으로 승패를 가린다.
+func (g *Game) handleTeamLeft(user *User) (bool, i18n.Message) {
+	delete(g.teams, user.ID)
+	if !g.settings.TeamMode || !g.inGame() {
+		return false, i18n.Message{}
+	}
+	return g.handleWinningTeamCheck()
+}
+
+func (g *Game) handleWinningTeamCheck() (bool, i18n.Message) {
+	active := g.activeTeams()
+	if len(active) != 1 {
+		return false, i18n.Message{}
+	}
+	msg := teamMessage(WINNERTEAMMSG, active[0])
+	g.transition(PhaseEventFinish)
+	g.message = msg
+	return true, msg
+}
+
+func (g *Game) addTeamScore(user *User) {
+	if team, ok := g.teams[user.ID]; ok && team < len(g.teamScores) {
+		g.teamScores[team]++
+	}
+}
+
+func (g *Game) makeTeamList(locale string) []TeamInfo {
+	if !g.settings.TeamMode {
+		return nil
+	}
+	teams := make([]TeamInfo, g.settings.TeamCount)
+	for team := range teams {
+		teams[team] = TeamInfo{
+			ID:      team,
+			Name:    render(locale, teamMessage(TEAMNAMEMSG, team)),
+			Players: make([]string, 0),
+		}
+		if team < len(g.teamLives) {
+			teams[team].Lives = g.teamLives[team]
+			teams[team].Score = g.teamScores[team]
+		}
+		for _, p := range g.teamMembers(team) {
+			teams[team].Players = append(teams[team].Players, p.Tag)
+		}
+	}
+	return teams
+}
+
+func (g *Game) isPlayer(userID string) bool {
+	for _, p := range g.players {
+		if p.ID == userID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package game
+
+import (
+	"testing"
+
+	"wordgame/internal/i18n"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// teamTestOptions 는 네 명이 두 팀으로 나뉘어 팀 목숨 둘로 겨루는 방이다.
+func teamTestOptions() []TestGameOption {
+	settings := DefaultRoomSettings()
+	settings.TeamMode = true
+	settings.TeamLives = 2
+	return []TestGameOption{WithPlayers(4), WithSettings(settings)}
+}
+
+func TestBalanceTeams(t *testing.T) {
+	g := SetupDefaultPlayers(teamTestOptions()...)
+	for _, p := range g.players {
+		g.teams[p.ID] = 0
+	}
+
+	g.balanceTeams()
+
+	assert.Len(t, g.teamMembers(0), 2, "Players should be split evenly")
+	assert.Len(t, g.teamMembers(1), 2, "Players should be split evenly")
+}
+
+func TestAssignTeam(t *testing.T) {
+	g := SetupDefaultPlayers(teamTestOptions()...)
+
+	assert.NoError(t, g.AssignTeam("1001", "7002", 0))
+	assert.Equal(t, 0, g.teams["1002"], "Host should move the player to the chosen team")
+	assert.ErrorIs(t, g.AssignTeam("1002", "7003", 0), ErrNotHost, "Only the host can assign teams")
+	assert.ErrorIs(t, g.AssignTeam("1001", "7003", 5), ErrInvalidTeam, "Unknown team should be rejected")
+	assert.ErrorIs(t, g.AssignTeam("1001", "9999", 0), ErrUserNotFound, "Unknown player should be rejected")
+	assert.ErrorIs(t, g.AssignTeam("1001", "1003", 0), ErrUserNotFound, "Players are picked by public ID, not session ID")
+
+	startReadyGame(g, g.players[0])
+	assert.ErrorIs(t, g.AssignTeam("1001", "7003", 0), ErrGameInProgress, "Teams cannot change during a game")
+}
+
+func TestAssignTeamDisabled(t *testing.T) {
+	g := SetupDefaultPlayers()
+
+	assert.ErrorIs(t, g.BalanceTeams(g.hostUserId), ErrTeamModeDisabled, "Free-for-all rooms have no teams")
+}
+
+func TestPrepareTeamsNotReady(t *testing.T) {
+	g := SetupDefaultPlayers(teamTestOptions()...)
+	for _, p := range g.players {
+		g.teams[p.ID] = 0
+	}
+
+	assert.ErrorIs(t, g.prepareTeams(), ErrTeamsNotReady, "Game needs at least two teams with players")
+}
+
+func TestTeamTurnsAlternate(t *testing.T) {
+	g := SetupDefaultPlayers(teamTestOptions()...)
+	assert.NoError(t, g.prepareTeams())
+	g.currentTeam = 0
+	g.takeTeamTurn(0)
+
+	turns := []string{g.currentUserID}
+	for i := 0; i < 3; i++ {
+		g.setNextTeamTurn()
+		turns = append(turns, g.currentUserID)
+	}
+
+	// 0팀: Alice, Charlie / 1팀: Bob, Dave
+	assert.Equal(t, []string{"1001", "1002", "1003", "1004"}, turns, "Turns should alternate between teams and rotate inside a team")
+}
+
+func TestPenalizeTeam(t *testing.T) {
+	g := SetupStartedGame(teamTestOptions()...)
+	player := currentPlayer(g)
+	team := g.teams[player.ID]
+	other := 1 - team
+
+	winner, _ := g.eliminatePlayer(player, i18n.New(WORDNOTINDICTMSG))
+	assert.False(t, winner)
+	assert.Equal(t, 1, g.teamLives[team], "Mistake should cost the team a life")
+	assert.Len(t, g.players, 4, "Nobody should be removed in team mode")
+	assert.Equal(t, other, g.teams[g.currentUserID], "Turn should pass to the other team")
+
+	winner, msg := g.eliminatePlayer(player, i18n.New(WORDNOTINDICTMSG))
+	assert.True(t, winner, "Team without lives should lose")
+	assert.Equal(t, other+1, msg.Params["team"], "Remaining team should win")
+	assert.True(t, g.isGameOver())
+}
+
+func TestTeamScore(t *testing.T) {
+	g := SetupDefaultPlayers(teamTestOptions()...)
+	assert.NoError(t, g.prepareTeams())
+
+	g.addTeamScore(g.players[1])
+	teams := g.makeTeamList(i18n.DefaultLocale)
+
+	assert.Len(t, teams, 2)
+	assert.Equal(t, 1, teams[1].Score, "Accepted word should score for the team")
+	assert.Equal(t, []string{"7002", "7004"}, teams[1].Players, "Roster should list the team members' public IDs")
+}
@@ -0,0 +1,130 @@
+package game
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+)
+
+var ErrConnClosed = errors.New("connection is closed")
+
+// Conn 은 User 가 클라이언트와 주고받는 연결이다. websocket.Conn 과 MemoryConn 이 구현한다.
+type Conn interface {
+	ReadMessage() (messageType int, p []byte, err error)
+	WriteMessage(messageType int, data []byte) error
+	Close() error
+}
+
+// MemoryConn 은 메모리 안에서만 주고받는 연결이다.
+// 서버 쪽은 Conn 으로 쓰고, 테스트는 클라이언트 쪽에서 Send 로 보내고 Next 로 받는다.
+type MemoryConn struct {
+	mu       sync.Mutex
+	received [][]byte // 서버가 보낸 텍스트 메시지
+	read     int
+	notify   chan struct{}
+
+	inbox     chan []byte
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func NewMemoryConn() *MemoryConn {
+	return &MemoryConn{
+		notify: make(chan struct{}, 1),
+		inbox:  make(chan []byte, MemoryConnBuffer),
+		closed: make(chan struct{}),
+	}
+}
+
+// ReadMessage 는 클라이언트가 Send 로 보낸 메시지를 기다린다. 연결이 닫히면 ErrConnClosed 를 돌려준다.
+func (c *MemoryConn) ReadMessage() (int, []byte, error) {
+	select {
+	case msg := <-c.inbox:
+		return websocket.TextMessage, msg, nil
+	case <-c.closed:
+		return 0, nil, ErrConnClosed
+	}
+}
+
+// WriteMessage 는 서버가 보낸 메시지를 쌓는다. 닫기 메시지를 받으면 연결을 닫는다.
+func (c *MemoryConn) WriteMessage(messageType int, data []byte) error {
+	if c.IsClosed() {
+		return ErrConnClosed
+	}
+	if messageType == websocket.CloseMessage {
+		return c.Close()
+	}
+
+	c.mu.Lock()
+	c.received = append(c.received, append([]byte(nil), data...))
+	c.mu.Unlock()
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (c *MemoryConn) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+	})
+	return nil
+}
+
+func (c *MemoryConn) IsClosed() bool {
+	select {
+	case <-c.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Send 는 클라이언트가 서버로 메시지를 보낸다.
+func (c *MemoryConn) Send(data []byte) error {
+	select {
+	case <-c.closed:
+		return ErrConnClosed
+	case c.inbox <- data:
+		return nil
+	}
+}
+
+// Next 는 아직 읽지 않은 서버 메시지 중 가장 앞의 것을 timeout 까지 기다려 돌려준다.
+func (c *MemoryConn) Next(timeout time.Duration) ([]byte, error) {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	for {
+		c.mu.Lock()
+		if c.read < len(c.received) {
+			msg := c.received[c.read]
+			c.read++
+			c.mu.Unlock()
+			return msg, nil
+		}
+		c.mu.Unlock()
+
+		select {
+		case <-c.notify:
+		case <-c.closed:
+			c.mu.Lock()
+			pending := c.read < len(c.received)
+			c.mu.Unlock()
+			if !pending {
+				return nil, ErrConnClosed
+			}
+		case <-deadline.C:
+			return nil, errors.New("timed out waiting for message")
+		}
+	}
+}
+
+// Received 는 지금까지 서버가 보낸 메시지를 모두 돌려준다.
+func (c *MemoryConn) Received() [][]byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([][]byte(nil), c.received...)
+}
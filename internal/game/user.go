@@ -1,59 +1,138 @@
 package game
 
 import (
-	"errors"
-	"log"
+	"encoding/json"
+	"log/slog"
 	"sync"
 
+	"wordgame/internal/i18n"
+	"wordgame/internal/logging"
+	"wordgame/internal/metrics"
+	"wordgame/internal/ratelimit"
+
 	"github.com/gofiber/contrib/websocket"
 )
 
 type User struct {
-	conn      *websocket.Conn
+	conn      Conn
 	ID        string
+	Tag       string
 	Name      string
+	Locale    string
 	game      *Game
 	mu        sync.RWMutex
 	closeOnce sync.Once
+	logger    *slog.Logger
+
+	// BotDifficulty 가 있으면 연결 없이 서버가 대신 두는 봇이다.
+	BotDifficulty string
+
+	limiter       *ratelimit.Bucket
+	violations    int
+	maxViolations int
+}
+
+func NewUser(conn Conn, ID string, Name string, Locale string, logger *slog.Logger) *User {
+	return &User{
+		conn:   conn,
+		ID:     ID,
+		Name:   Name,
+		Locale: Locale,
+		logger: logger.With(logging.UserIDKey, ID, logging.UserNameKey, Name),
+	}
 }
 
-func NewUser(conn *websocket.Conn, ID string, Name string) *User {
+// NewBot 은 연결이 없는 봇 플레이어를 만든다.
+func NewBot(ID string, difficulty string, logger *slog.Logger) *User {
 	return &User{
-		conn: conn,
-		ID:   ID,
-		Name: Name,
+		ID:            ID,
+		Name:          BOTNAME,
+		BotDifficulty: difficulty,
+		logger:        logger.With(logging.UserIDKey, ID, "bot", difficulty),
 	}
 }
 
+func (u *User) IsBot() bool {
+	return u.BotDifficulty != ""
+}
+
 func (u *User) ReadLoop() {
 	defer func() {
-		log.Printf("Read loop for client %s ended.", u.ID)
+		logging.Debug(u.log(), READLOOPENDLOGMSG)
 		u.Close()
 	}()
 
 	for {
 		msg, err := u.ReadMessage()
 		if err != nil {
-			log.Printf("Error reading message for client %s: %v", u.ID, err)
+			logging.Debug(u.log(), READERRORLOGMSG, logging.ErrorKey, err)
 			break
 		}
+		if !u.allowMessage() {
+			if u.isAbusive() {
+				logging.Warn(u.log(), ABUSEDISCONNECTLOGMSG, "violations", u.violations)
+				u.sendError(TOOMANYMESSAGESCODE, i18n.New(TOOMANYMESSAGESMSG))
+				break
+			}
+			logging.Debug(u.log(), RATELIMITEDLOGMSG, "violations", u.violations)
+			if u.game != nil {
+				u.game.sendNotice(u, RATELIMITEDCODE, i18n.New(RATELIMITEDMSG))
+			}
+			continue
+		}
 		if u.game != nil {
 			u.game.HandleMessage(u, msg)
 		}
 	}
 }
 
+// setMessageLimit 은 연결 하나가 보낼 수 있는 메시지 속도를 정한다.
+func (u *User) setMessageLimit(limits Limits) {
+	if limits.MessagesPerSecond <= 0 {
+		return
+	}
+	burst := limits.MessageBurst
+	if burst < 1 {
+		burst = 1
+	}
+	u.limiter = ratelimit.NewBucket(limits.MessagesPerSecond, burst)
+	u.maxViolations = limits.MaxMessageViolations
+}
+
+// allowMessage 는 ReadLoop 고루틴에서만 호출된다.
+func (u *User) allowMessage() bool {
+	if u.limiter == nil || u.limiter.Allow() {
+		return true
+	}
+	u.violations++
+	metrics.RateLimited.WithLabelValues("message").Inc()
+	return false
+}
+
+// isAbusive 는 한도를 넘긴 메시지가 너무 많아 연결을 끊어야 하는지 확인한다.
+func (u *User) isAbusive() bool {
+	return u.maxViolations > 0 && u.violations >= u.maxViolations
+}
+
+func (u *User) sendError(code string, message i18n.Message) {
+	bytes, err := json.Marshal(makeNoticeMessage(ERRORJSONTYPE, u.Locale, code, message))
+	if err != nil {
+		return
+	}
+	_ = u.WriteMessage(websocket.TextMessage, bytes)
+}
+
 func (u *User) ReadMessage() ([]byte, error) {
 	conn := u.getConn()
 	if conn == nil {
-		return nil, errors.New("connection is closed")
+		return nil, ErrConnClosed
 	}
 	_, msg, err := conn.ReadMessage()
 	if err != nil {
 		u.checkNormalClosure(err)
 		return nil, err
 	}
-	log.Printf("Received message from %s: %s", u.Name, string(msg))
+	logging.Debug(u.log(), MESSAGERECEIVEDLOGMSG, "payload", string(msg))
 	return msg, nil
 }
 
@@ -62,7 +141,7 @@ func (u *User) WriteMessage(messageType int, data []byte) error {
 	defer u.mu.Unlock()
 
 	if u.conn == nil {
-		return errors.New("connection is closed")
+		return ErrConnClosed
 	}
 	return u.conn.WriteMessage(messageType, data)
 }
@@ -78,7 +157,7 @@ func (u *User) Close() {
 	})
 }
 
-func (u *User) getConn() *websocket.Conn {
+func (u *User) getConn() Conn {
 	u.mu.RLock()
 	defer u.mu.RUnlock()
 	return u.conn
@@ -86,6 +165,13 @@ func (u *User) getConn() *websocket.Conn {
 
 func (u *User) checkNormalClosure(err error) {
 	if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
-		log.Printf("Read error for client %s: %v", u.Name, err)
+		logging.Warn(u.log(), READERRORLOGMSG, logging.ErrorKey, err)
+	}
+}
+
+func (u *User) log() *slog.Logger {
+	if u.logger == nil {
+		return slog.Default()
 	}
+	return u.logger
 }
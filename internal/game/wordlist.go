@@ -0,0 +1,111 @@
+package game
+
+import (
+	"errors"
+
+	"wordgame/internal/logging"
+	"wordgame/internal/store"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrInvalidWordListMode = errors.New("invalid word list mode")
+	ErrWordListTooLarge    = errors.New("word list is too large")
+	ErrWordListEmpty       = errors.New("word list is empty")
+	ErrGameInProgress      = errors.New("game is in progress")
+)
+
+// CustomWordList 는 방에서만 쓰이는 단어 목록이다.
+// allow 모드면 목록에 있는 단어만, block 모드면 목록에 없는 사전 단어만 인정한다.
+type CustomWordList struct {
+	Mode      string
+	words     map[string]bool
+	list      []string
+	normalize func(word string) string
+}
+
+// NewCustomWordList 는 단어를 방 언어의 정규화 함수(Dictionary.Normalize)로 다듬어 목록을 만든다.
+func NewCustomWordList(mode string, words []string, normalize func(word string) string) (*CustomWordList, error) {
+	if !store.IsValidWordListMode(mode) {
+		return nil, ErrInvalidWordListMode
+	}
+	if len(words) > MaxCustomWordListSize {
+		return nil, ErrWordListTooLarge
+	}
+
+	wl := &CustomWordList{
+		Mode:      mode,
+		words:     make(map[string]bool),
+		list:      make([]string, 0, len(words)),
+		normalize: normalize,
+	}
+	for _, w := range words {
+		w = normalize(w)
+		if w == "" || wl.words[w] {
+			continue
+		}
+		wl.words[w] = true
+		wl.list = append(wl.list, w)
+	}
+	if len(wl.list) == 0 {
+		return nil, ErrWordListEmpty
+	}
+	return wl, nil
+}
+
+func (wl *CustomWordList) Contains(word string) bool {
+	return wl.words[wl.normalize(word)]
+}
+
+func (wl *CustomWordList) Words() []string {
+	return wl.list
+}
+
+func (wl *CustomWordList) Len() int {
+	return len(wl.list)
+}
+
+func (g *Game) SetWordList(wl *CustomWordList) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.isStarted() {
+		return ErrGameInProgress
+	}
+	g.wordList = wl
+	if wl != nil {
+		logging.Info(g.logger, WORDLISTSETLOGMSG, "mode", wl.Mode, "words", wl.Len())
+	}
+	return nil
+}
+
+// IsHost 는 세션 ID 가 방장의 것인지 본다. 공개 ID(태그)로는 방장임을 증명할 수 없다.
+func (g *Game) IsHost(userID string) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return userID != "" && g.hostUserId == userID
+}
+
+func (g *Game) isWordAllowedByList(word string) (allowed bool, checkDict bool) {
+	if g.wordList == nil {
+		return true, true
+	}
+	switch g.wordList.Mode {
+	case store.WordListModeAllow:
+		return g.wordList.Contains(word), false
+	case store.WordListModeBlock:
+		return !g.wordList.Contains(word), true
+	}
+	return true, true
+}
+
+func (g *Game) makeWordListInfo() fiber.Map {
+	if g.wordList == nil {
+		return nil
+	}
+	return fiber.Map{
+		"mode": g.wordList.Mode,
+		"size": g.wordList.Len(),
+	}
+}
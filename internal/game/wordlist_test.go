@@ -0,0 +1,61 @@
+package game
+
+import (
+	"testing"
+
+	"wordgame/internal/store"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCustomWordList(t *testing.T) {
+	wl, err := NewCustomWordList(store.WordListModeAllow, []string{"사과", " 사과 ", "", "바-나나"}, store.NormalizeWord)
+
+	assert.NoError(t, err, "Valid word list should be created")
+	assert.Equal(t, 2, wl.Len(), "Blank and duplicate words should be ignored")
+	assert.True(t, wl.Contains("바나나"), "Words should be normalized")
+
+	_, err = NewCustomWordList("unknown", []string{"사과"}, store.NormalizeWord)
+	assert.ErrorIs(t, err, ErrInvalidWordListMode, "Unknown mode should be rejected")
+
+	_, err = NewCustomWordList(store.WordListModeBlock, []string{" "}, store.NormalizeWord)
+	assert.ErrorIs(t, err, ErrWordListEmpty, "Empty word list should be rejected")
+}
+
+func TestWordDBCheckWithAllowList(t *testing.T) {
+	g := SetupTestGame()
+	wl, _ := NewCustomWordList(store.WordListModeAllow, []string{"김치", "치즈"}, g.dictionary().Normalize)
+	assert.NoError(t, g.SetWordList(wl))
+
+	assert.True(t, g.wordDBCheck("김치"), "Allow-listed word should be accepted without the dictionary")
+	assert.False(t, g.wordDBCheck("사과"), "Word outside the allow list should be rejected")
+	assert.Contains(t, wl.Words(), g.makeStartWord(), "Start word should come from the allow list")
+}
+
+func TestWordDBCheckWithBlockList(t *testing.T) {
+	g := SetupTestGame()
+	wl, _ := NewCustomWordList(store.WordListModeBlock, []string{"사과"}, g.dictionary().Normalize)
+	assert.NoError(t, g.SetWordList(wl))
+
+	assert.False(t, g.wordDBCheck("사과"), "Blocked word should be rejected")
+}
+
+func TestWordListFoldsEnglishCase(t *testing.T) {
+	g := SetupTestGame()
+	settings := DefaultRoomSettings()
+	settings.Language = store.LanguageEnglish
+	g.ApplySettings(settings)
+	wl, _ := NewCustomWordList(store.WordListModeAllow, []string{"Apple", "EGG"}, g.dictionary().Normalize)
+	assert.NoError(t, g.SetWordList(wl))
+
+	assert.Equal(t, []string{"apple", "egg"}, wl.Words(), "English words should be stored in lower case")
+	assert.True(t, g.wordDBCheck("apple"), "Submitted word should match the list regardless of case")
+	assert.True(t, wl.Contains("Egg"), "Lookups should fold case too")
+}
+
+func TestSetWordListWhileStarted(t *testing.T) {
+	g := SetupStartedGame()
+	wl, _ := NewCustomWordList(store.WordListModeAllow, []string{"김치"}, g.dictionary().Normalize)
+
+	assert.ErrorIs(t, g.SetWordList(wl), ErrGameInProgress, "Word list should not change during a game")
+}
@@ -1,26 +1,38 @@
 package handler
 
 import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+
 	"wordgame/internal/game"
+	"wordgame/internal/logging"
 	"wordgame/internal/store"
 
 	"github.com/gofiber/fiber/v2"
 )
 
+// AccountKeyHeader 는 저장한 단어 목록을 조회할 때 계정 키를 싣는 헤더다.
+const AccountKeyHeader = "X-Account-Key"
+
 type APIHandler struct {
 	RoomManager *game.RoomManager
 	DBManager   *store.DBManager
+	GameStore   game.Store // 새로 만드는 방이 쓰는 사전과 저장소. 테스트는 game.MemoryStore 로 바꾼다.
+	logger      *slog.Logger
 }
 
-func NewAPIHandler(rm *game.RoomManager, db *store.DBManager) *APIHandler {
-	return &APIHandler{RoomManager: rm, DBManager: db}
+func NewAPIHandler(rm *game.RoomManager, db *store.DBManager, logger *slog.Logger) *APIHandler {
+	return &APIHandler{RoomManager: rm, DBManager: db, GameStore: game.NewDBStore(db), logger: logger}
 }
 
 func (a *APIHandler) RegisterRoutes(app *fiber.App) {
 	api := app.Group("/api")
 	api.Get("/rooms", a.GetRooms)
 	api.Post("/rooms", a.CreateRoom)
-
+	api.Post("/rooms/:id/wordlist", a.SetRoomWordList)
+	api.Get("/wordlists", a.GetWordLists)
+	api.Get("/games/:id/replay", a.GetGameReplay)
 }
 
 func (a *APIHandler) GetRooms(c *fiber.Ctx) error {
@@ -29,18 +41,192 @@ func (a *APIHandler) GetRooms(c *fiber.Ctx) error {
 }
 
 type CreateRoomRequest struct {
-	RoomName string `json:"roomName"`
+	RoomName   string `json:"roomName"`
+	Account    string `json:"account"` // 단어 목록을 저장할 때 받은 계정 키
+	WordListID uint   `json:"wordListId"`
+	game.RoomSettings
 }
 
 func (a *APIHandler) CreateRoom(c *fiber.Ctx) error {
-	req := new(CreateRoomRequest)
+	if a.RoomManager.IsMaintenance() {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": "server is under maintenance"})
+	}
+
+	req := &CreateRoomRequest{RoomSettings: game.DefaultRoomSettings()}
 	if err := c.BodyParser(req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "cannot parse request"})
 	}
 	if req.RoomName == "" {
 		req.RoomName = "새로운 방"
 	}
+	if !game.IsValidChainRule(req.ChainRule) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "unknown chain rule"})
+	}
+	if !store.IsValidLanguage(req.Language) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "unknown language"})
+	}
 
-	game := a.RoomManager.MakeRoom(req.RoomName, a.DBManager)
+	var wordList *game.CustomWordList
+	if req.WordListID != 0 {
+		wl, err := a.loadSavedWordList(req.WordListID, req.Account, a.GameStore.Dictionary(req.Language))
+		if err != nil {
+			return wordListError(c, err)
+		}
+		wordList = wl
+	}
+	if (wordList == nil || wordList.Mode != store.WordListModeAllow) && game.IsDictionaryEmpty(a.GameStore.Dictionary(req.Language)) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "dictionary for the language is empty"})
+	}
+
+	game, err := a.RoomManager.MakeRoom(req.RoomName, a.GameStore)
+	if err != nil {
+		logging.Warn(a.logger, ROOMCREATEERRORLOGMSG, logging.ErrorKey, err)
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": err.Error()})
+	}
+	game.ApplySettings(req.RoomSettings)
+	if wordList != nil {
+		_ = game.SetWordList(wordList)
+	}
 	return c.JSON(fiber.Map{"id": game.RoomId, "roomName": game.RoomName})
 }
+
+// WordListRequest 는 방 단어 목록을 바꾸는 요청이다. SessionID 는 환영 메시지의 yourId 로,
+// 방에 방송되지 않으므로 방장 본인만 알 수 있다. Account 는 처음 저장할 때 서버가 돌려준 계정 키다.
+type WordListRequest struct {
+	SessionID string   `json:"sessionId"`
+	Mode      string   `json:"mode"`
+	Words     []string `json:"words"`
+	ListID    uint     `json:"listId"`
+	Account   string   `json:"account"`
+	SaveAs    string   `json:"saveAs"`
+}
+
+func (a *APIHandler) SetRoomWordList(c *fiber.Ctx) error {
+	roomID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid room id"})
+	}
+	room, exists := a.RoomManager.GetRoom(roomID)
+	if !exists {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "room not found"})
+	}
+
+	req := new(WordListRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "cannot parse request"})
+	}
+	if !room.IsHost(req.SessionID) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "only the host can change the word list"})
+	}
+
+	dict := a.GameStore.Dictionary(room.Settings().Language)
+	var wordList *game.CustomWordList
+	if req.ListID != 0 {
+		wordList, err = a.loadSavedWordList(req.ListID, req.Account, dict)
+	} else {
+		wordList, err = game.NewCustomWordList(req.Mode, req.Words, dict.Normalize)
+	}
+	if err != nil {
+		return wordListError(c, err)
+	}
+
+	if err := room.SetWordList(wordList); err != nil {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	resp := fiber.Map{"mode": wordList.Mode, "size": wordList.Len()}
+	if req.ListID == 0 && req.SaveAs != "" {
+		account, err := a.wordListAccount(req.Account)
+		if err != nil {
+			return wordListError(c, err)
+		}
+		saved := store.NewWordList(account, req.SaveAs, wordList.Mode, wordList.Words())
+		if err := a.DBManager.SaveWordList(saved); err != nil {
+			logging.Error(a.logger, WORDLISTSAVEERRORLOGMSG, logging.RoomIDKey, roomID, logging.ErrorKey, err)
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "cannot save word list"})
+		}
+		resp["listId"] = saved.ID
+		resp["account"] = account
+	}
+	return c.JSON(resp)
+}
+
+// GetWordLists 는 X-Account-Key 헤더의 계정 키로 저장한 단어 목록을 돌려준다.
+func (a *APIHandler) GetWordLists(c *fiber.Ctx) error {
+	account := c.Get(AccountKeyHeader)
+	if account == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "account key is required"})
+	}
+	lists, err := a.DBManager.GetWordListsByAccount(account)
+	if err != nil {
+		logging.Error(a.logger, WORDLISTLOADERRORLOGMSG, logging.ErrorKey, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "cannot load word lists"})
+	}
+	return c.JSON(lists)
+}
+
+// GetGameReplay 는 끝난 게임의 이벤트 기록을 JSON 으로 내보낸다.
+func (a *APIHandler) GetGameReplay(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid game id"})
+	}
+	replay, err := a.DBManager.GetGameReplay(uint(id))
+	if err != nil {
+		if errors.Is(err, store.ErrGameReplayNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		logging.Error(a.logger, REPLAYLOADERRORLOGMSG, logging.ErrorKey, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "cannot load game replay"})
+	}
+	return c.JSON(fiber.Map{
+		"id":        replay.ID,
+		"roomId":    replay.RoomID,
+		"roomName":  replay.RoomName,
+		"startedAt": replay.StartedAt,
+		"endedAt":   replay.EndedAt,
+		"events":    json.RawMessage(replay.Events),
+	})
+}
+
+// loadSavedWordList 는 저장된 목록을 방 언어의 사전 정규화로 다시 만든다.
+func (a *APIHandler) loadSavedWordList(id uint, account string, dict game.Dictionary) (*game.CustomWordList, error) {
+	saved, err := a.DBManager.GetWordList(id)
+	if err != nil {
+		return nil, err
+	}
+	if !saved.OwnedBy(account) {
+		return nil, store.ErrWordListNotFound
+	}
+	return game.NewCustomWordList(saved.Mode, saved.Entries(), dict.Normalize)
+}
+
+// wordListAccount 는 목록을 저장할 계정 키를 고른다. 키가 없으면 새로 만들고,
+// 있으면 서버가 만들어 준 키인지(저장된 목록이 있는지) 확인한다.
+func (a *APIHandler) wordListAccount(key string) (string, error) {
+	if key == "" {
+		return store.NewAccountKey()
+	}
+	lists, err := a.DBManager.GetWordListsByAccount(key)
+	if err != nil {
+		return "", err
+	}
+	if len(lists) == 0 {
+		return "", store.ErrUnknownAccount
+	}
+	return key, nil
+}
+
+func wordListError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, store.ErrWordListNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, store.ErrUnknownAccount):
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, game.ErrInvalidWordListMode),
+		errors.Is(err, game.ErrWordListTooLarge),
+		errors.Is(err, game.ErrWordListEmpty):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "cannot load word list"})
+}
@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"wordgame/internal/game"
+	"wordgame/internal/i18n"
+	"wordgame/internal/logging"
+	"wordgame/internal/store"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AdminHandler struct {
+	RoomManager *game.RoomManager
+	DBManager   *store.DBManager
+	token       string
+	logger      *slog.Logger
+}
+
+func NewAdminHandler(rm *game.RoomManager, db *store.DBManager, token string, logger *slog.Logger) *AdminHandler {
+	return &AdminHandler{RoomManager: rm, DBManager: db, token: token, logger: logger}
+}
+
+func (a *AdminHandler) RegisterRoutes(app *fiber.App) {
+	admin := app.Group("/api/admin", a.authenticate)
+	admin.Get("/suggestions", a.GetSuggestions)
+	admin.Post("/suggestions/:id/approve", a.ApproveSuggestion)
+	admin.Post("/suggestions/:id/reject", a.RejectSuggestion)
+
+	admin.Get("/rooms", a.GetRooms)
+	admin.Post("/rooms/:id/end", a.EndRoomGame)
+	admin.Delete("/rooms/:id", a.CloseRoom)
+	admin.Post("/rooms/:id/kick", a.KickUser)
+	admin.Post("/announce", a.Announce)
+	admin.Get("/maintenance", a.GetMaintenance)
+	admin.Post("/maintenance", a.SetMaintenance)
+}
+
+// authenticate 는 Authorization: Bearer <ADMIN_TOKEN> 헤더를 확인한다.
+// 토큰이 설정되지 않았으면 관리자 API는 모두 막힌다.
+func (a *AdminHandler) authenticate(c *fiber.Ctx) error {
+	if a.token == "" {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "admin api is disabled"})
+	}
+	token := strings.TrimPrefix(c.Get(fiber.HeaderAuthorization), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(a.token)) != 1 {
+		logging.Warn(a.logger, ADMINUNAUTHORIZEDLOGMSG, "ip", c.IP(), "path", c.Path())
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	return c.Next()
+}
+
+func (a *AdminHandler) GetSuggestions(c *fiber.Ctx) error {
+	status := c.Query("status", store.SuggestionStatusPending)
+	suggestions, err := a.DBManager.GetWordSuggestions(status)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "cannot load suggestions"})
+	}
+	return c.JSON(suggestions)
+}
+
+func (a *AdminHandler) ApproveSuggestion(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid suggestion id"})
+	}
+	suggestion, err := a.DBManager.ApproveWordSuggestion(uint(id))
+	if err != nil {
+		return suggestionError(c, err)
+	}
+	return c.JSON(suggestion)
+}
+
+func (a *AdminHandler) RejectSuggestion(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid suggestion id"})
+	}
+	suggestion, err := a.DBManager.RejectWordSuggestion(uint(id))
+	if err != nil {
+		return suggestionError(c, err)
+	}
+	return c.JSON(suggestion)
+}
+
+func suggestionError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, store.ErrSuggestionNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, store.ErrSuggestionAlreadyHandled):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "cannot review suggestion"})
+}
+
+func (a *AdminHandler) GetRooms(c *fiber.Ctx) error {
+	return c.JSON(a.RoomManager.GetRoomDetails())
+}
+
+func (a *AdminHandler) EndRoomGame(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid room id"})
+	}
+	room, exists := a.RoomManager.GetRoom(id)
+	if !exists {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "room not found"})
+	}
+	if err := room.ForceEnd(); err != nil {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(fiber.Map{"id": room.RoomId, "ended": true})
+}
+
+func (a *AdminHandler) CloseRoom(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid room id"})
+	}
+	if !a.RoomManager.CloseRoom(id, i18n.New(game.ROOMCLOSEDMSG)) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "room not found"})
+	}
+	return c.JSON(fiber.Map{"id": id, "closed": true})
+}
+
+type KickRequest struct {
+	UserID string `json:"userId"` // 관리자 상태에 보이는 공개 ID(태그)
+}
+
+func (a *AdminHandler) KickUser(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid room id"})
+	}
+	room, exists := a.RoomManager.GetRoom(id)
+	if !exists {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "room not found"})
+	}
+	req := new(KickRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "cannot parse request"})
+	}
+	if err := room.KickUser(req.UserID); err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(fiber.Map{"id": room.RoomId, "kicked": req.UserID})
+}
+
+type AnnounceRequest struct {
+	Message string `json:"message"`
+}
+
+func (a *AdminHandler) Announce(c *fiber.Ctx) error {
+	req := new(AnnounceRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "cannot parse request"})
+	}
+	if strings.TrimSpace(req.Message) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "message is required"})
+	}
+	rooms := a.RoomManager.Announce(req.Message)
+	return c.JSON(fiber.Map{"rooms": rooms})
+}
+
+type MaintenanceRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+func (a *AdminHandler) GetMaintenance(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{"enabled": a.RoomManager.IsMaintenance()})
+}
+
+func (a *AdminHandler) SetMaintenance(c *fiber.Ctx) error {
+	req := new(MaintenanceRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "cannot parse request"})
+	}
+	a.RoomManager.SetMaintenance(req.Enabled)
+	return c.JSON(fiber.Map{"enabled": req.Enabled})
+}
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"wordgame/internal/metrics"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type MetricsHandler struct {
+	Registry *metrics.Registry
+}
+
+func NewMetricsHandler(registry *metrics.Registry) *MetricsHandler {
+	return &MetricsHandler{Registry: registry}
+}
+
+func (m *MetricsHandler) RegisterRoutes(app *fiber.App) {
+	app.Get("/metrics", m.GetMetrics)
+}
+
+func (m *MetricsHandler) GetMetrics(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderContentType, metrics.ContentType)
+	m.Registry.WriteText(c)
+	return nil
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"wordgame/internal/logging"
+	"wordgame/internal/metrics"
+	"wordgame/internal/ratelimit"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RateLimitHandler 는 IP마다 API 요청 속도를 제한한다. 관리자 토큰이나 단어 목록 계정 키를
+// 실은 요청은 그 계정에도 같은 제한을 걸어, 여러 IP로 나눠 보내도 한도를 넘지 못한다.
+// 다른 핸들러보다 먼저 등록해야 한다.
+type RateLimitHandler struct {
+	API        *ratelimit.Limiter
+	CreateRoom *ratelimit.Limiter
+	logger     *slog.Logger
+}
+
+func NewRateLimitHandler(api, createRoom *ratelimit.Limiter, logger *slog.Logger) *RateLimitHandler {
+	return &RateLimitHandler{API: api, CreateRoom: createRoom, logger: logger}
+}
+
+func (r *RateLimitHandler) RegisterRoutes(app *fiber.App) {
+	if r.API != nil {
+		app.Use("/api", r.limit("api", r.API))
+	}
+	if r.CreateRoom != nil {
+		app.Post("/api/rooms", r.limit("create_room", r.CreateRoom))
+	}
+}
+
+func (r *RateLimitHandler) limit(scope string, limiter *ratelimit.Limiter) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		allowed, retryAfter := limiter.Allow(c.IP())
+		if allowed {
+			if account := rateLimitAccount(c); account != "" {
+				allowed, retryAfter = limiter.Allow(account)
+			}
+		}
+		if allowed {
+			return c.Next()
+		}
+		metrics.RateLimited.WithLabelValues(scope).Inc()
+		logging.Debug(r.logger, RATELIMITEDLOGMSG, "scope", scope, "ip", c.IP(), "path", c.Path())
+
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(retryAfterSeconds(retryAfter)))
+		return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{"error": "too many requests"})
+	}
+}
+
+// rateLimitAccount 는 요청을 보낸 계정의 제한 키를 만든다. 관리자 토큰, X-Account-Key 헤더,
+// JSON 본문의 account 순서로 찾고, 계정이 없으면 빈 문자열이다. 키 자체는 남기지 않도록 해시만 쓴다.
+func rateLimitAccount(c *fiber.Ctx) string {
+	scope, key := "admin", strings.TrimPrefix(c.Get(fiber.HeaderAuthorization), "Bearer ")
+	if key == "" {
+		scope, key = "account", c.Get(AccountKeyHeader)
+	}
+	if key == "" {
+		key = bodyAccount(c)
+	}
+	if key == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(key))
+	return scope + ":" + hex.EncodeToString(sum[:8])
+}
+
+func bodyAccount(c *fiber.Ctx) string {
+	if !strings.HasPrefix(c.Get(fiber.HeaderContentType), fiber.MIMEApplicationJSON) {
+		return ""
+	}
+	var body struct {
+		Account string `json:"account"`
+	}
+	if err := json.Unmarshal(c.Body(), &body); err != nil {
+		return ""
+	}
+	return body.Account
+}
+
+func retryAfterSeconds(d time.Duration) int {
+	return int(math.Max(1, math.Ceil(d.Seconds())))
+}
@@ -1,25 +1,44 @@
 package handler
 
 import (
-	"log"
+	"encoding/json"
+	"errors"
+	"log/slog"
 	"strconv"
-	
+	"sync"
+
 	"wordgame/internal/game"
+	"wordgame/internal/i18n"
+	"wordgame/internal/logging"
+	"wordgame/internal/store"
+
 	"github.com/gofiber/contrib/websocket"
 	"github.com/gofiber/fiber/v2"
-
 )
 
 type WSHandler struct {
 	RoomManager *game.RoomManager
+	DBManager   *store.DBManager
+	logger      *slog.Logger
+
+	ipMutex       sync.Mutex
+	ipConnections map[string]int
 }
 
-func NewWSHandler(rm *game.RoomManager) *WSHandler {
-	return &WSHandler{RoomManager: rm}
+func NewWSHandler(rm *game.RoomManager, db *store.DBManager, logger *slog.Logger) *WSHandler {
+	return &WSHandler{
+		RoomManager:   rm,
+		DBManager:     db,
+		logger:        logger,
+		ipConnections: make(map[string]int),
+	}
 }
 
 
 func (ws *WSHandler) RegisterRoutes(app fiber.Router) {
+	app.Get("/ws/replay/:gameId", websocket.New(func(c *websocket.Conn) {
+		ws.handleReplay(c)
+	}))
 	app.Get("/ws/:roomId", websocket.New(func(c *websocket.Conn) {
 		ws.handleWebSocket(c)
 	}))
@@ -31,20 +50,108 @@ func (ws *WSHandler) handleWebSocket(conn *websocket.Conn) {
 	if name == "" {
 		name = "user"
 	}
+	// ?lang= 이 없으면 브라우저의 Accept-Language 로 메시지 언어를 정한다.
+	locale := i18n.ParseLocale(conn.Query("lang"), conn.Headers(fiber.HeaderAcceptLanguage))
 
 	id, err := strconv.Atoi(roomId)
 	if err != nil {
-		log.Println("Invalid room ID:", roomId)
-		_ = conn.Close()
+		logging.Warn(ws.logger, INVALIDROOMIDLOGMSG, logging.RoomIDKey, roomId)
+		game.RejectConnection(conn, locale, game.ROOMNOTFOUNDCODE, i18n.New(game.ROOMNOTFOUNDMSG))
 		return
 	}
 
 	gameObj, exists := ws.RoomManager.GetRoom(id)
 	if !exists {
-		log.Println("Room not found:", roomId)
-		_ = conn.Close()
+		logging.Warn(ws.logger, ROOMNOTFOUNDLOGMSG, logging.RoomIDKey, id)
+		game.RejectConnection(conn, locale, game.ROOMNOTFOUNDCODE, i18n.New(game.ROOMNOTFOUNDMSG))
+		return
+	}
+
+	ip := conn.IP()
+	if !ws.acquireIP(ip) {
+		logging.Warn(ws.logger, TOOMANYCONNECTIONSLOGMSG, "ip", ip)
+		game.RejectConnection(conn, locale, game.TOOMANYCONNECTIONSCODE, i18n.New(game.TOOMANYCONNECTIONSMSG))
+		return
+	}
+	defer ws.releaseIP(ip)
+
+	if err := ws.RoomManager.AcquireConnection(); err != nil {
+		logging.Warn(ws.logger, SERVERFULLLOGMSG, "ip", ip)
+		game.RejectConnection(conn, locale, game.SERVERFULLCODE, i18n.New(game.SERVERFULLMSG))
+		return
+	}
+	defer ws.RoomManager.ReleaseConnection()
+
+	// AddClient는 연결이 끊길 때까지 반환되지 않는다.
+	gameObj.AddClient(conn, name, locale)
+}
+
+// handleReplay 는 끝난 게임을 라이브 게임과 같은 상태 메시지로 다시 보여준다.
+// ?speed= 로 재생 속도를 정하며, 방에는 들어가지 않는다. 라이브 연결과 같은 접속 한도를 쓰며,
+// 한도를 넘으면 기록을 읽기 전에 거절한다.
+func (ws *WSHandler) handleReplay(conn *websocket.Conn) {
+	locale := i18n.ParseLocale(conn.Query("lang"), conn.Headers(fiber.HeaderAcceptLanguage))
+
+	id, err := strconv.ParseUint(conn.Params("gameId"), 10, 0)
+	if err != nil {
+		logging.Warn(ws.logger, INVALIDGAMEIDLOGMSG, "game_id", conn.Params("gameId"))
+		game.RejectConnection(conn, locale, game.REPLAYNOTFOUNDCODE, i18n.New(game.REPLAYNOTFOUNDMSG))
+		return
+	}
+
+	ip := conn.IP()
+	if !ws.acquireIP(ip) {
+		logging.Warn(ws.logger, TOOMANYCONNECTIONSLOGMSG, "ip", ip)
+		game.RejectConnection(conn, locale, game.TOOMANYCONNECTIONSCODE, i18n.New(game.TOOMANYCONNECTIONSMSG))
+		return
+	}
+	defer ws.releaseIP(ip)
+
+	if err := ws.RoomManager.AcquireConnection(); err != nil {
+		logging.Warn(ws.logger, SERVERFULLLOGMSG, "ip", ip)
+		game.RejectConnection(conn, locale, game.SERVERFULLCODE, i18n.New(game.SERVERFULLMSG))
+		return
+	}
+	defer ws.RoomManager.ReleaseConnection()
+
+	replay, err := ws.DBManager.GetGameReplay(uint(id))
+	if err != nil {
+		if !errors.Is(err, store.ErrGameReplayNotFound) {
+			logging.Error(ws.logger, REPLAYLOADERRORLOGMSG, logging.ErrorKey, err)
+		}
+		game.RejectConnection(conn, locale, game.REPLAYNOTFOUNDCODE, i18n.New(game.REPLAYNOTFOUNDMSG))
+		return
+	}
+	var events []game.ReplayEvent
+	if err := json.Unmarshal([]byte(replay.Events), &events); err != nil {
+		logging.Error(ws.logger, REPLAYDECODEERRORLOGMSG, "game_id", id, logging.ErrorKey, err)
+		game.RejectConnection(conn, locale, game.REPLAYNOTFOUNDCODE, i18n.New(game.REPLAYNOTFOUNDMSG))
 		return
 	}
 
-	gameObj.AddClient(conn, name)
+	speed, _ := strconv.ParseFloat(conn.Query("speed"), 64)
+	game.StreamReplay(conn, events, locale, game.ReplaySpeed(speed))
+}
+
+func (ws *WSHandler) acquireIP(ip string) bool {
+	limit := ws.RoomManager.Limits().MaxConnectionsPerIP
+
+	ws.ipMutex.Lock()
+	defer ws.ipMutex.Unlock()
+
+	if limit > 0 && ws.ipConnections[ip] >= limit {
+		return false
+	}
+	ws.ipConnections[ip]++
+	return true
+}
+
+func (ws *WSHandler) releaseIP(ip string) {
+	ws.ipMutex.Lock()
+	defer ws.ipMutex.Unlock()
+
+	ws.ipConnections[ip]--
+	if ws.ipConnections[ip] <= 0 {
+		delete(ws.ipConnections, ip)
+	}
 }
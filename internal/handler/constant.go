@@ -0,0 +1,17 @@
+package handler
+
+// 핸들러가 남기는 로그 이벤트 이름이다.
+const (
+	ROOMCREATEERRORLOGMSG    = "room_create_failed"
+	WORDLISTSAVEERRORLOGMSG  = "word_list_save_failed"
+	WORDLISTLOADERRORLOGMSG  = "word_list_load_failed"
+	REPLAYLOADERRORLOGMSG    = "game_replay_load_failed"
+	REPLAYDECODEERRORLOGMSG  = "game_replay_decode_failed"
+	ADMINUNAUTHORIZEDLOGMSG  = "admin_unauthorized"
+	RATELIMITEDLOGMSG        = "request_rate_limited"
+	INVALIDROOMIDLOGMSG      = "invalid_room_id"
+	ROOMNOTFOUNDLOGMSG       = "room_not_found"
+	INVALIDGAMEIDLOGMSG      = "invalid_game_id"
+	TOOMANYCONNECTIONSLOGMSG = "too_many_connections"
+	SERVERFULLLOGMSG         = "server_full"
+)
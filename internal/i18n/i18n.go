@@ -0,0 +1,120 @@
+package i18n
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	LocaleKorean  = "ko"
+	LocaleEnglish = "en"
+
+	DefaultLocale = LocaleKorean
+
+	// SequenceCode 는 여러 메시지를 이어 붙인 메시지의 코드다.
+	SequenceCode = "sequence"
+)
+
+var Locales = []string{LocaleKorean, LocaleEnglish}
+
+// Message 는 클라이언트가 그대로 다룰 수 있는 메시지 코드와 인자다.
+// 인자 값이 Message 면 같은 언어로 렌더링해서 넣는다.
+type Message struct {
+	Code   string         `json:"code"`
+	Params map[string]any `json:"params,omitempty"`
+}
+
+// New 는 코드와 key, value 순서의 인자로 메시지를 만든다.
+func New(code string, keyValues ...any) Message {
+	msg := Message{Code: code}
+	if len(keyValues) == 0 {
+		return msg
+	}
+	msg.Params = make(map[string]any, len(keyValues)/2)
+	for i := 0; i+1 < len(keyValues); i += 2 {
+		msg.Params[fmt.Sprint(keyValues[i])] = keyValues[i+1]
+	}
+	return msg
+}
+
+// Join 은 여러 메시지를 공백으로 이어 붙인 메시지를 만든다.
+func Join(parts ...Message) Message {
+	return Message{Code: SequenceCode, Params: map[string]any{"parts": parts}}
+}
+
+func (m Message) IsZero() bool {
+	return m.Code == ""
+}
+
+// Catalog 는 언어별 메시지 템플릿이다. 템플릿의 {name} 은 인자로 바뀐다.
+type Catalog struct {
+	templates map[string]map[string]string
+}
+
+func NewCatalog(templates map[string]map[string]string) *Catalog {
+	return &Catalog{templates: templates}
+}
+
+// Render 는 메시지를 locale 로 렌더링한다. 템플릿이 없으면 기본 언어, 그래도 없으면 코드를 그대로 쓴다.
+func (c *Catalog) Render(locale string, msg Message) string {
+	if msg.Code == SequenceCode {
+		return c.renderParam(locale, msg.Params["parts"])
+	}
+
+	template, ok := c.templates[locale][msg.Code]
+	if !ok {
+		template, ok = c.templates[DefaultLocale][msg.Code]
+	}
+	if !ok {
+		return msg.Code
+	}
+	if len(msg.Params) == 0 {
+		return template
+	}
+
+	pairs := make([]string, 0, len(msg.Params)*2)
+	for key, value := range msg.Params {
+		pairs = append(pairs, "{"+key+"}", c.renderParam(locale, value))
+	}
+	return strings.NewReplacer(pairs...).Replace(template)
+}
+
+func (c *Catalog) renderParam(locale string, value any) string {
+	switch v := value.(type) {
+	case Message:
+		return c.Render(locale, v)
+	case []Message:
+		rendered := make([]string, 0, len(v))
+		for _, part := range v {
+			if s := c.Render(locale, part); s != "" {
+				rendered = append(rendered, s)
+			}
+		}
+		return strings.Join(rendered, " ")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// ParseLocale 는 ?lang= 값이나 Accept-Language 헤더에서 지원하는 첫 언어를 고른다.
+func ParseLocale(candidates ...string) string {
+	for _, candidate := range candidates {
+		for _, part := range strings.Split(candidate, ",") {
+			tag := strings.TrimSpace(strings.SplitN(part, ";", 2)[0])
+			base := strings.ToLower(strings.SplitN(tag, "-", 2)[0])
+			if IsSupported(base) {
+				return base
+			}
+		}
+	}
+	return DefaultLocale
+}
+
+func IsSupported(locale string) bool {
+	for _, l := range Locales {
+		if l == locale {
+			return true
+		}
+	}
+	return false
+}
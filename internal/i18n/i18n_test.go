@@ -0,0 +1,58 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testCatalog = NewCatalog(map[string]map[string]string{
+	LocaleKorean: {
+		"turn":   "{player}님의 차례입니다.",
+		"out":    "{player}님이 탈락했습니다. 이유 : {reason}",
+		"reason": "사전에 없는 단어입니다.",
+		"only":   "한국어만 있는 메시지",
+	},
+	LocaleEnglish: {
+		"turn":   "It's {player}'s turn.",
+		"out":    "{player} has been eliminated. Reason: {reason}",
+		"reason": "The word is not in the dictionary.",
+	},
+})
+
+func TestRender(t *testing.T) {
+	msg := New("out", "player", "Alice", "reason", New("reason"))
+
+	assert.Equal(t, "Alice님이 탈락했습니다. 이유 : 사전에 없는 단어입니다.", testCatalog.Render(LocaleKorean, msg))
+	assert.Equal(t, "Alice has been eliminated. Reason: The word is not in the dictionary.", testCatalog.Render(LocaleEnglish, msg))
+}
+
+func TestRenderFallback(t *testing.T) {
+	assert.Equal(t, "한국어만 있는 메시지", testCatalog.Render(LocaleEnglish, New("only")), "Missing template should fall back to the default locale")
+	assert.Equal(t, "unknown", testCatalog.Render(LocaleEnglish, New("unknown")), "Unknown code should be rendered as is")
+}
+
+func TestRenderJoin(t *testing.T) {
+	msg := Join(New("reason"), New("turn", "player", "Bob"))
+
+	assert.Equal(t, "The word is not in the dictionary. It's Bob's turn.", testCatalog.Render(LocaleEnglish, msg))
+}
+
+func TestParseLocale(t *testing.T) {
+	testCases := []struct {
+		name       string
+		candidates []string
+		expected   string
+	}{
+		{name: "query", candidates: []string{"en", "ko-KR"}, expected: LocaleEnglish},
+		{name: "accept-language", candidates: []string{"", "en-US,en;q=0.9,ko;q=0.8"}, expected: LocaleEnglish},
+		{name: "unsupported first", candidates: []string{"fr-FR,ko;q=0.5"}, expected: LocaleKorean},
+		{name: "empty", candidates: []string{"", ""}, expected: DefaultLocale},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ParseLocale(tc.candidates...))
+		})
+	}
+}
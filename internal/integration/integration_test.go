@@ -0,0 +1,391 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"wordgame/internal/game"
+	"wordgame/internal/handler"
+	"wordgame/internal/i18n"
+	"wordgame/internal/random"
+	"wordgame/internal/store"
+
+	"github.com/fasthttp/websocket"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	waitTimeout = 3 * time.Second
+	testSeed    = 1 // 방 번호, 태그, 시작 단어가 실행마다 같도록 고정한 난수 시드
+)
+
+// testWords 는 통합 테스트용 사전이다. 길이별로 가장 앞 단어가 시작 단어가 되므로 모두 이어지는 단어가 있다.
+var testWords = []string{
+	"사과", "과일", "일기", "기차", "차표", "표지", "지도", "도시", "시소", "소나무",
+	"무지개", "개나리", "리본", "본문", "문어", "어부", "부자", "자동차", "차고", "고구마",
+	"마차", "바나나", "계단", "단어", "어린이날", "날씨", "씨앗", "자동차경주", "주사",
+}
+
+type state struct {
+	LastWord      string            `json:"lastWord"`
+	Players       []game.PlayerInfo `json:"players"`
+	Spectators    []game.PlayerInfo `json:"spectators"`
+	CurrentTurn   string            `json:"currentTurnPlayerId"`
+	Phase         game.Phase        `json:"phase"`
+	HostUserID    string            `json:"hostUserId"`
+	IsGameOver    bool              `json:"isGameOver"`
+	IsStarted     bool              `json:"isStarted"`
+	MessageCode   string            `json:"messageCode"`
+	MessageParams map[string]any    `json:"messageParams"`
+}
+
+type server struct {
+	addr string
+}
+
+// startServer 는 메모리 사전을 쓰는 서버를 빈 로컬 포트에 띄운다.
+func startServer(t *testing.T) *server {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	rm := game.NewRoomManager(random.NewSeededManager(testSeed), logger)
+	db := &store.DBManager{}
+
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	api := handler.NewAPIHandler(rm, db, logger)
+	api.GameStore = game.NewMemoryStore(testWords...)
+	api.RegisterRoutes(app)
+	handler.NewWSHandler(rm, db, logger).RegisterRoutes(app)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		_ = app.Listener(ln)
+	}()
+	t.Cleanup(func() {
+		_ = app.Shutdown()
+	})
+	return &server{addr: ln.Addr().String()}
+}
+
+// createRoom 은 기본 설정으로 방을 만든다. 테스트가 오래 걸리지 않도록 카운트다운만 1초로 줄인다.
+func (s *server) createRoom(t *testing.T, name string) int {
+	body, _ := json.Marshal(map[string]any{"roomName": name, "countdownSeconds": 1})
+	resp, err := http.Post("http://"+s.addr+"/api/rooms", fiber.MIMEApplicationJSON, bytes.NewReader(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var room struct {
+		ID       int    `json:"id"`
+		RoomName string `json:"roomName"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&room))
+	assert.Equal(t, name, room.RoomName)
+	return room.ID
+}
+
+type client struct {
+	t    *testing.T
+	conn *websocket.Conn
+	id   string // 환영 메시지로만 받는 세션 ID
+	tag  string // 상태에 보이는 공개 ID
+	msgs chan []byte
+}
+
+// connect 는 방에 웹소켓으로 들어가 환영 메시지에서 공개 ID 를 받는다.
+func (s *server) connect(t *testing.T, roomID int, name string) *client {
+	u := fmt.Sprintf("ws://%s/ws/%d?name=%s&lang=ko", s.addr, roomID, url.QueryEscape(name))
+	conn, _, err := websocket.DefaultDialer.Dial(u, nil)
+	require.NoError(t, err)
+
+	c := &client{t: t, conn: conn, msgs: make(chan []byte, 256)}
+	go c.readLoop()
+	t.Cleanup(c.close)
+
+	for {
+		msg := c.next()
+		var welcome game.WelcomeMessage
+		if json.Unmarshal(msg, &welcome) == nil && welcome.Type == game.WELCOMEJSONTYPE {
+			c.id = welcome.YourId
+			c.tag = welcome.YourTag
+			return c
+		}
+	}
+}
+
+func (c *client) readLoop() {
+	defer close(c.msgs)
+	for {
+		_, msg, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		c.msgs <- msg
+	}
+}
+
+func (c *client) close() {
+	_ = c.conn.Close()
+}
+
+func (c *client) next() []byte {
+	select {
+	case msg, ok := <-c.msgs:
+		require.True(c.t, ok, "connection closed while waiting for a message")
+		return msg
+	case <-time.After(waitTimeout):
+		require.FailNow(c.t, "timed out waiting for a message")
+		return nil
+	}
+}
+
+func (c *client) send(msgType string, payload any) {
+	bytes, _ := json.Marshal(game.GameMessage{Type: msgType, Payload: payload})
+	require.NoError(c.t, c.conn.WriteMessage(websocket.TextMessage, bytes))
+}
+
+// waitState 는 조건에 맞는 상태 메시지가 올 때까지 기다린다. 알림은 건너뛴다.
+func (c *client) waitState(match func(state) bool) state {
+	for {
+		msg := c.next()
+		var typed struct {
+			Type string `json:"type"`
+		}
+		if json.Unmarshal(msg, &typed) != nil || typed.Type != "" {
+			continue
+		}
+		var s state
+		require.NoError(c.t, json.Unmarshal(msg, &s))
+		if match(s) {
+			return s
+		}
+	}
+}
+
+func allReady(players []game.PlayerInfo) bool {
+	for _, p := range players {
+		if !p.Ready {
+			return false
+		}
+	}
+	return true
+}
+
+func find(clients []*client, tag string) *client {
+	for _, c := range clients {
+		if c.tag == tag {
+			return c
+		}
+	}
+	return nil
+}
+
+func others(clients []*client, leaving *client) []*client {
+	var rest []*client
+	for _, c := range clients {
+		if c != leaving {
+			rest = append(rest, c)
+		}
+	}
+	return rest
+}
+
+// nextWord 는 테스트 사전에서 last 에 이어지는 다른 단어를 고른다.
+func nextWord(last string) string {
+	tail, _ := utf8.DecodeLastRuneInString(last)
+	for _, w := range testWords {
+		head, _ := utf8.DecodeRuneInString(w)
+		if head == tail && w != last {
+			return w
+		}
+	}
+	return ""
+}
+
+// startGame 은 방을 만들고 players 명을 접속시켜 모두 준비하게 한 뒤 첫 번째 접속자(방장)가 게임을 시작한다.
+// 모든 접속자가 카운트다운이 끝난 상태를 받을 때까지 기다리므로 이후에는 시작 뒤의 메시지만 남는다.
+func startGame(t *testing.T, players int) ([]*client, state) {
+	s := startServer(t)
+	roomID := s.createRoom(t, "Integration Room")
+	names := []string{"Alice", "Bob", "Charlie", "Dave"}
+	clients := make([]*client, players)
+	for i := range clients {
+		clients[i] = s.connect(t, roomID, names[i])
+	}
+
+	for _, c := range clients {
+		c.send(game.TOGGLEREADYJSONTYPE, nil)
+	}
+	clients[0].waitState(func(s state) bool { return len(s.Players) == players && allReady(s.Players) })
+	clients[0].send(game.STARTJSONTYPE, nil)
+	var started state
+	for _, c := range clients {
+		started = c.waitState(func(s state) bool { return s.Phase == game.PhaseInTurn })
+	}
+	require.Len(t, started.Players, players)
+	require.Equal(t, clients[0].tag, started.HostUserID)
+	return clients, started
+}
+
+func TestGames(t *testing.T) {
+	testCases := []struct {
+		name    string
+		players int
+		play    func(t *testing.T, clients []*client, start state)
+	}{
+		{
+			name:    "valid chain",
+			players: 2,
+			play: func(t *testing.T, clients []*client, start state) {
+				first := find(clients, start.CurrentTurn)
+				word := nextWord(start.LastWord)
+				require.NotEmpty(t, word)
+
+				first.send(game.SUBMITJSONTYPE, word)
+				var next string
+				for _, c := range clients {
+					s := c.waitState(func(s state) bool { return s.LastWord == word })
+					assert.NotEqual(t, first.tag, s.CurrentTurn, "Every client should see the turn move on")
+					next = s.CurrentTurn
+				}
+
+				second := find(clients, next)
+				require.NotNil(t, second)
+				reply := nextWord(word)
+				require.NotEmpty(t, reply)
+				second.send(game.SUBMITJSONTYPE, reply)
+				s := first.waitState(func(s state) bool { return s.LastWord == reply })
+				assert.Equal(t, first.tag, s.CurrentTurn, "Turn should come back to the first player")
+			},
+		},
+		{
+			name:    "used word",
+			players: 2,
+			play: func(t *testing.T, clients []*client, start state) {
+				current := find(clients, start.CurrentTurn)
+
+				current.send(game.SUBMITJSONTYPE, start.LastWord)
+				s := clients[0].waitState(func(s state) bool { return s.IsGameOver })
+
+				assert.Equal(t, game.WINNERMSG, s.MessageCode)
+				assert.Equal(t, others(clients, current)[0].tag, s.MessageParams["playerId"], "The other player should win")
+			},
+		},
+		{
+			name:    "mismatch",
+			players: 3,
+			play: func(t *testing.T, clients []*client, start state) {
+				current := find(clients, start.CurrentTurn)
+
+				current.send(game.SUBMITJSONTYPE, "바나나")
+				s := clients[0].waitState(func(s state) bool { return len(s.Spectators) == 1 })
+
+				assert.Equal(t, i18n.SequenceCode, s.MessageCode, "Elimination should be followed by a new round")
+				assert.Equal(t, current.tag, s.Spectators[0].ID, "The player who broke the chain should be eliminated")
+				assert.Len(t, s.Players, 2)
+			},
+		},
+		{
+			name:    "disconnect mid-turn",
+			players: 3,
+			play: func(t *testing.T, clients []*client, start state) {
+				current := find(clients, start.CurrentTurn)
+				rest := others(clients, current)
+
+				current.close()
+				s := rest[0].waitState(func(s state) bool { return len(s.Players) == 2 })
+
+				assert.True(t, s.IsStarted, "Game should go on with the remaining players")
+				assert.NotEqual(t, current.tag, s.CurrentTurn, "Turn should pass to a remaining player")
+				assert.NotNil(t, find(rest, s.CurrentTurn))
+			},
+		},
+		{
+			name:    "host leaving",
+			players: 3,
+			play: func(t *testing.T, clients []*client, start state) {
+				host := clients[0]
+				rest := others(clients, host)
+
+				host.close()
+				s := rest[0].waitState(func(s state) bool { return len(s.Players) == 2 })
+
+				assert.NotEqual(t, host.tag, s.HostUserID, "A remaining player should become the host")
+				assert.NotNil(t, find(rest, s.HostUserID))
+				assert.True(t, s.IsStarted)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clients, start := startGame(t, tc.players)
+			tc.play(t, clients, start)
+		})
+	}
+}
+
+func TestJoinUnknownRoom(t *testing.T) {
+	s := startServer(t)
+
+	conn, _, err := websocket.DefaultDialer.Dial(fmt.Sprintf("ws://%s/ws/1", s.addr), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, msg, err := conn.ReadMessage()
+	require.NoError(t, err)
+	var notice game.NoticeMessage
+	require.NoError(t, json.Unmarshal(msg, &notice))
+	assert.Equal(t, game.ERRORJSONTYPE, notice.Type)
+	assert.Equal(t, game.ROOMNOTFOUNDCODE, notice.Code)
+}
+
+func TestWordListNeedsHostSession(t *testing.T) {
+	s := startServer(t)
+	roomID := s.createRoom(t, "wordlist")
+	host := s.connect(t, roomID, "host")
+	guest := s.connect(t, roomID, "guest")
+	st := guest.waitState(func(st state) bool { return len(st.Players) == 2 })
+	require.Equal(t, host.tag, st.HostUserID)
+
+	testCases := []struct {
+		name      string
+		sessionID string
+		status    int
+	}{
+		{name: "host public id is not enough", sessionID: st.HostUserID, status: http.StatusForbidden},
+		{name: "other player", sessionID: guest.id, status: http.StatusForbidden},
+		{name: "host session", sessionID: host.id, status: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			body, _ := json.Marshal(handler.WordListRequest{SessionID: tc.sessionID, Mode: store.WordListModeAllow, Words: testWords})
+			resp, err := http.Post(fmt.Sprintf("http://%s/api/rooms/%d/wordlist", s.addr, roomID), fiber.MIMEApplicationJSON, bytes.NewReader(body))
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, tc.status, resp.StatusCode)
+		})
+	}
+}
+
+func TestCreateRoomWithEmptyDictionary(t *testing.T) {
+	s := startServer(t)
+
+	body, _ := json.Marshal(map[string]any{"roomName": "english", "language": store.LanguageEnglish})
+	resp, err := http.Post("http://"+s.addr+"/api/rooms", fiber.MIMEApplicationJSON, bytes.NewReader(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "A room nobody can play should not be created")
+}
@@ -0,0 +1,79 @@
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+const (
+	EventKey    = "event"
+	RoomIDKey   = "room_id"
+	UserIDKey   = "user_id"
+	UserNameKey = "user_name"
+	ErrorKey    = "error"
+
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// New 는 레벨(debug, info, warn, error)과 출력 형식(json, text)에 맞는 로거를 만든다.
+func New(w io.Writer, level, format string) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: ParseLevel(level)}
+	if strings.EqualFold(format, FormatJSON) {
+		return slog.New(slog.NewJSONHandler(w, opts))
+	}
+	return slog.New(slog.NewTextHandler(w, opts))
+}
+
+// NewFromEnv 는 LOG_LEVEL, LOG_FORMAT 환경변수로 로거를 만든다.
+func NewFromEnv() *slog.Logger {
+	return New(os.Stderr, os.Getenv("LOG_LEVEL"), os.Getenv("LOG_FORMAT"))
+}
+
+func ParseLevel(level string) slog.Level {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	}
+	return slog.LevelInfo
+}
+
+// Discard 는 아무것도 남기지 않는 로거다.
+func Discard() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func Debug(logger *slog.Logger, event string, args ...any) {
+	logEvent(logger, slog.LevelDebug, event, args...)
+}
+
+func Info(logger *slog.Logger, event string, args ...any) {
+	logEvent(logger, slog.LevelInfo, event, args...)
+}
+
+func Warn(logger *slog.Logger, event string, args ...any) {
+	logEvent(logger, slog.LevelWarn, event, args...)
+}
+
+func Error(logger *slog.Logger, event string, args ...any) {
+	logEvent(logger, slog.LevelError, event, args...)
+}
+
+// logEvent 는 메시지와 같은 값을 event 필드로 붙여 남긴다.
+func logEvent(logger *slog.Logger, level slog.Level, event string, args ...any) {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	ctx := context.Background()
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+	logger.Log(ctx, level, event, append([]any{EventKey, event}, args...)...)
+}
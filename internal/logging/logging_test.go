@@ -0,0 +1,50 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLevel(t *testing.T) {
+	testCases := []struct {
+		level    string
+		expected slog.Level
+	}{
+		{level: "debug", expected: slog.LevelDebug},
+		{level: "WARN", expected: slog.LevelWarn},
+		{level: "error", expected: slog.LevelError},
+		{level: "", expected: slog.LevelInfo},
+		{level: "unknown", expected: slog.LevelInfo},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, ParseLevel(tc.level), "ParseLevel(%q)", tc.level)
+	}
+}
+
+func TestInfoWritesEventField(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(&buf, "info", FormatJSON).With(RoomIDKey, 1234)
+
+	Info(logger, "player_entered", UserIDKey, "1001")
+
+	var entry map[string]any
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "player_entered", entry["msg"])
+	assert.Equal(t, "player_entered", entry[EventKey])
+	assert.Equal(t, float64(1234), entry[RoomIDKey])
+	assert.Equal(t, "1001", entry[UserIDKey])
+}
+
+func TestDebugIsFilteredByLevel(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(&buf, "info", FormatText)
+
+	Debug(logger, "state_broadcast")
+
+	assert.Empty(t, buf.String(), "Debug logs should not be written at info level")
+}
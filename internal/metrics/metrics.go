@@ -0,0 +1,28 @@
+package metrics
+
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+var (
+	LatencyBuckets = []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1}
+	SizeBuckets    = []float64{64, 128, 256, 512, 1024, 2048, 4096, 8192, 16384}
+)
+
+var Default = NewRegistry()
+
+var (
+	ActiveRooms    = Default.NewGauge("wordgame_active_rooms", "Number of rooms in the room manager.")
+	ConnectedUsers = Default.NewGauge("wordgame_connected_users", "Number of connected websocket users.")
+
+	GamesStarted  = Default.NewCounter("wordgame_games_started_total", "Number of games started.")
+	GamesFinished = Default.NewCounter("wordgame_games_finished_total", "Number of games finished.")
+
+	WordsSubmitted = Default.NewCounter("wordgame_words_submitted_total", "Number of words submitted.")
+	WordsAccepted  = Default.NewCounter("wordgame_words_accepted_total", "Number of words accepted.")
+	WordsRejected  = Default.NewCounterVec("wordgame_words_rejected_total", "Number of words rejected by reason.", "reason")
+
+	RateLimited = Default.NewCounterVec("wordgame_rate_limited_total", "Number of requests or messages rejected by rate limiting.", "scope")
+
+	DictionaryLookupSeconds = Default.NewHistogram("wordgame_dictionary_lookup_seconds", "Latency of dictionary lookups.", LatencyBuckets)
+	BroadcastSeconds        = Default.NewHistogram("wordgame_broadcast_seconds", "Latency of broadcasting a message to a room.", LatencyBuckets)
+	BroadcastMessageBytes   = Default.NewHistogram("wordgame_broadcast_message_bytes", "Size of broadcast messages in bytes.", SizeBuckets)
+)
@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"io"
+	"sync"
+)
+
+// Registry 는 등록된 지표를 Prometheus 텍스트 형식으로 내보낸다.
+type Registry struct {
+	mu         sync.Mutex
+	collectors []collector
+}
+
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+func (r *Registry) NewCounter(name, help string) *Counter {
+	c := &Counter{}
+	r.register(&counterMetric{metric: metric{name: name, help: help, kind: "counter"}, value: c})
+	return c
+}
+
+func (r *Registry) NewGauge(name, help string) *Gauge {
+	g := &Gauge{}
+	r.register(&counterMetric{metric: metric{name: name, help: help, kind: "gauge"}, value: &g.Counter})
+	return g
+}
+
+func (r *Registry) NewCounterVec(name, help string, labels ...string) *CounterVec {
+	v := &CounterVec{
+		metric: metric{name: name, help: help, kind: "counter"},
+		labels: labels,
+		values: make(map[string]*Counter),
+	}
+	r.register(v)
+	return v
+}
+
+func (r *Registry) NewHistogram(name, help string, buckets []float64) *Histogram {
+	h := newHistogram(buckets)
+	r.register(&histogramMetric{metric: metric{name: name, help: help, kind: "histogram"}, value: h})
+	return h
+}
+
+func (r *Registry) WriteText(w io.Writer) {
+	r.mu.Lock()
+	collectors := append([]collector(nil), r.collectors...)
+	r.mu.Unlock()
+
+	for _, c := range collectors {
+		c.write(w)
+	}
+}
+
+func (r *Registry) register(c collector) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.collectors = append(r.collectors, c)
+}
@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteText(t *testing.T) {
+	r := NewRegistry()
+	counter := r.NewCounter("test_total", "Test counter.")
+	gauge := r.NewGauge("test_gauge", "Test gauge.")
+	vec := r.NewCounterVec("test_rejected_total", "Test counter vec.", "reason")
+	histogram := r.NewHistogram("test_seconds", "Test histogram.", []float64{0.1, 1})
+
+	counter.Inc()
+	counter.Add(2)
+	gauge.Inc()
+	gauge.Inc()
+	gauge.Dec()
+	vec.WithLabelValues("mismatch").Inc()
+	vec.WithLabelValues("mismatch").Inc()
+	vec.WithLabelValues("already_used").Inc()
+	histogram.Observe(0.05)
+	histogram.Observe(0.5)
+	histogram.Observe(5)
+
+	var sb strings.Builder
+	r.WriteText(&sb)
+	out := sb.String()
+
+	assert.Contains(t, out, "# TYPE test_total counter\ntest_total 3\n")
+	assert.Contains(t, out, "# TYPE test_gauge gauge\ntest_gauge 1\n")
+	assert.Contains(t, out, "test_rejected_total{reason=\"already_used\"} 1\ntest_rejected_total{reason=\"mismatch\"} 2\n")
+	assert.Contains(t, out, "test_seconds_bucket{le=\"0.1\"} 1\n")
+	assert.Contains(t, out, "test_seconds_bucket{le=\"1\"} 2\n")
+	assert.Contains(t, out, "test_seconds_bucket{le=\"+Inf\"} 3\n")
+	assert.Contains(t, out, "test_seconds_sum 5.55\n")
+	assert.Contains(t, out, "test_seconds_count 3\n")
+}
+
+func TestGaugeSet(t *testing.T) {
+	r := NewRegistry()
+	gauge := r.NewGauge("test_gauge", "Test gauge.")
+
+	gauge.Set(42)
+
+	assert.Equal(t, float64(42), gauge.Value())
+}
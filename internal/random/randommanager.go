@@ -2,19 +2,36 @@ package random
 
 import (
 	"math/rand"
+	"sync"
 	"time"
 )
 
+// Manager 는 여러 고루틴(방 생성, ID 생성, 방장 선택 등)에서 함께 쓰므로 잠금으로 보호한다.
 type Manager struct {
-	rnd *rand.Rand
+	mu   sync.Mutex
+	rnd  *rand.Rand
+	seed int64
 }
 
 func NewManager() *Manager {
+	return NewSeededManager(time.Now().UnixNano())
+}
+
+// NewSeededManager 는 seed 가 같으면 항상 같은 순서로 수를 뽑는다. 테스트나 버그 재현에 쓴다.
+func NewSeededManager(seed int64) *Manager {
 	return &Manager{
-		rnd: rand.New(rand.NewSource(time.Now().UnixNano())),
+		rnd:  rand.New(rand.NewSource(seed)),
+		seed: seed,
 	}
 }
 
+// Seed 는 처음 쓴 시드다. 로그에 남겨 두면 같은 흐름을 다시 만들 수 있다.
+func (r *Manager) Seed() int64 {
+	return r.seed
+}
+
 func (r *Manager) MakeRandomNumber(min, max int) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	return r.rnd.Intn(max-min) + min
 }
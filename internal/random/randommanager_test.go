@@ -0,0 +1,34 @@
+package random
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeededManagerIsReproducible(t *testing.T) {
+	first, second := NewSeededManager(42), NewSeededManager(42)
+
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, first.MakeRandomNumber(0, 1000), second.MakeRandomNumber(0, 1000), "Same seed should give the same numbers")
+	}
+	assert.Equal(t, int64(42), first.Seed())
+}
+
+func TestManagerConcurrentUse(t *testing.T) {
+	r := NewManager()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				n := r.MakeRandomNumber(10, 20)
+				assert.GreaterOrEqual(t, n, 10)
+				assert.Less(t, n, 20)
+			}
+		}()
+	}
+	wg.Wait()
+}
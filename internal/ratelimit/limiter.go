@@ -0,0 +1,91 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+const cleanupInterval = time.Minute
+
+type Config struct {
+	Rate     float64       // 초당 허용 요청 수
+	Burst    int           // 한 번에 몰아서 허용할 요청 수
+	BanAfter int           // 이 횟수만큼 거절되면 차단한다. 0이면 차단하지 않는다.
+	BanFor   time.Duration // 차단 시간
+}
+
+type entry struct {
+	bucket      *Bucket
+	violations  int
+	bannedUntil time.Time
+	lastSeen    time.Time
+}
+
+// Limiter 는 키(IP 등)마다 따로 토큰 버킷을 두고, 계속 한도를 넘는 키는 잠시 차단한다.
+type Limiter struct {
+	mu          sync.Mutex
+	cfg         Config
+	entries     map[string]*entry
+	lastCleanup time.Time
+	now         func() time.Time
+}
+
+func New(cfg Config) *Limiter {
+	return newLimiter(cfg, time.Now)
+}
+
+func newLimiter(cfg Config, now func() time.Time) *Limiter {
+	return &Limiter{
+		cfg:         cfg,
+		entries:     make(map[string]*entry),
+		lastCleanup: now(),
+		now:         now,
+	}
+}
+
+// Allow 는 요청을 허용할지와, 거절했다면 다시 시도할 때까지의 시간을 돌려준다.
+func (l *Limiter) Allow(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.cleanup(now)
+
+	e, ok := l.entries[key]
+	if !ok {
+		e = &entry{bucket: newBucket(l.cfg.Rate, l.cfg.Burst, l.now)}
+		l.entries[key] = e
+	}
+	e.lastSeen = now
+
+	if now.Before(e.bannedUntil) {
+		return false, e.bannedUntil.Sub(now)
+	}
+
+	allowed, wait := e.bucket.Reserve()
+	if allowed {
+		e.violations = 0
+		return true, 0
+	}
+
+	e.violations++
+	if l.cfg.BanAfter > 0 && e.violations >= l.cfg.BanAfter {
+		e.bannedUntil = now.Add(l.cfg.BanFor)
+		e.violations = 0
+		return false, l.cfg.BanFor
+	}
+	return false, wait
+}
+
+// cleanup 은 오래 쓰이지 않은 키를 지운다. (잠금은 호출자가 관리)
+func (l *Limiter) cleanup(now time.Time) {
+	if now.Sub(l.lastCleanup) < cleanupInterval {
+		return
+	}
+	l.lastCleanup = now
+	for key, e := range l.entries {
+		if now.Sub(e.lastSeen) > cleanupInterval && now.After(e.bannedUntil) {
+			delete(l.entries, key)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.t
+}
+
+func (c *fakeClock) advance(d time.Duration) {
+	c.t = c.t.Add(d)
+}
+
+func TestBucketRefill(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	b := newBucket(1, 2, clock.now)
+
+	assert.True(t, b.Allow(), "First token should be available")
+	assert.True(t, b.Allow(), "Burst token should be available")
+	ok, wait := b.Reserve()
+	assert.False(t, ok, "Bucket should be empty after the burst")
+	assert.Equal(t, time.Second, wait, "Next token should be ready after one second")
+
+	clock.advance(time.Second)
+	assert.True(t, b.Allow(), "Token should be refilled after one second")
+}
+
+func TestLimiterPerKey(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	l := newLimiter(Config{Rate: 1, Burst: 1}, clock.now)
+
+	ok, _ := l.Allow("1.1.1.1")
+	assert.True(t, ok)
+	ok, _ = l.Allow("1.1.1.1")
+	assert.False(t, ok, "Same key should be limited")
+	ok, _ = l.Allow("2.2.2.2")
+	assert.True(t, ok, "Other keys should have their own bucket")
+}
+
+func TestLimiterBan(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	l := newLimiter(Config{Rate: 1, Burst: 1, BanAfter: 2, BanFor: time.Minute}, clock.now)
+
+	l.Allow("abuser")
+	l.Allow("abuser")
+	ok, retry := l.Allow("abuser")
+	assert.False(t, ok)
+	assert.Equal(t, time.Minute, retry, "Persistent abuser should be banned")
+
+	clock.advance(30 * time.Second)
+	ok, _ = l.Allow("abuser")
+	assert.False(t, ok, "Banned key should stay blocked even after tokens refill")
+
+	clock.advance(31 * time.Second)
+	ok, _ = l.Allow("abuser")
+	assert.True(t, ok, "Ban should expire")
+}
@@ -2,9 +2,11 @@ package store
 
 import (
 	"fmt"
-	"log"
+	"log/slog"
 	"strings"
 
+	"wordgame/internal/logging"
+
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
@@ -17,7 +19,9 @@ type Word struct {
 }
 
 type DBManager struct {
-	DB *gorm.DB
+	DB           *gorm.DB
+	index        *WordIndex
+	dictionaries map[string]*Dictionary
 }
 
 func NewDBManager() (*DBManager, error) {
@@ -25,62 +29,56 @@ func NewDBManager() (*DBManager, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect database: %w", err)
 	}
-	log.Println("Database connection successfully established.")
-	return &DBManager{DB: db}, nil
+	logging.Info(slog.Default(), "database_connected")
+
+	if err := db.AutoMigrate(&WordList{}, &WordSuggestion{}, &GameResult{}, &GameReplay{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	manager := &DBManager{DB: db}
+	if err := manager.loadDictionaries(); err != nil {
+		return nil, fmt.Errorf("failed to load word index: %w", err)
+	}
+	return manager, nil
 }
 
 func (Word) TableName() string {
 	return "kr"
 }
-func (db *DBManager) IsWordInDB(word string) bool {
-	if db.DB == nil {
-		log.Println("Database is not initialized.")
-		return false
-	}
 
-	w := strings.TrimSpace(word)
-	normalized := normalizeWord(w)
-
-	if normalized == "" {
-		return false
+// Dictionary 는 언어에 맞는 사전을 돌려준다. 모르는 언어면 기본 언어 사전을 돌려준다.
+func (db *DBManager) Dictionary(code string) *Dictionary {
+	lang := LookupLanguage(code)
+	if d, ok := db.dictionaries[lang.Code]; ok {
+		return d
 	}
+	return &Dictionary{db: db.DB, lang: lang}
+}
 
-	var result Word
-	res := db.DB.Raw(
-		"SELECT * FROM kr WHERE word = ? OR REPLACE(REPLACE(REPLACE(word, '-', ''), '^', ''), ' ', '') = ? LIMIT 1",
-		w, normalized,
-	).Scan(&result)
-
-	if res.Error != nil {
-		log.Println("Error querying database:", res.Error)
-		return false
-	}
+// 아래 메서드들은 기본 언어(한국어) 사전을 쓴다.
 
-	return res.RowsAffected > 0
+func (db *DBManager) IsWordInDB(word string) bool {
+	return db.Dictionary(DefaultLanguage).IsWordInDB(word)
 }
 
 func (db *DBManager) GetRandomWordByLength(length int) (string, error) {
-	if db.DB == nil {
-		log.Println("Database is not initialized.")
-		return "", fmt.Errorf("database is not initialized")
-	}
-
-	var result Word
-	err := db.DB.Raw("SELECT * FROM kr WHERE LENGTH(word) = ? ORDER BY RANDOM() LIMIT 1", length).Scan(&result).Error
+	return db.Dictionary(DefaultLanguage).GetRandomWordByLength(length)
+}
 
-	if err != nil {
-		return "", err
+func (db *DBManager) loadDictionaries() error {
+	db.dictionaries = make(map[string]*Dictionary, len(languages))
+	for code, lang := range languages {
+		d := &Dictionary{db: db.DB, lang: lang}
+		if err := d.loadIndex(); err != nil {
+			return err
+		}
+		db.dictionaries[code] = d
 	}
-
-	randomWord := strings.TrimSpace(result.Word)
-	normalized := strings.ReplaceAll(randomWord, " ", "")
-	normalized = strings.ReplaceAll(normalized, "-", "")
-	normalized = strings.ReplaceAll(normalized, "^", "")
-
-	return normalized, nil
+	db.index = db.dictionaries[DefaultLanguage].index
+	return nil
 }
 
-func normalizeWord(s string) string {
+func NormalizeWord(s string) string {
 	s = strings.TrimSpace(s)
 	replacer := strings.NewReplacer(" ", "", "-", "", "^", "")
 	return replacer.Replace(s)
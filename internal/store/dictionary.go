@@ -0,0 +1,116 @@
+package store
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"wordgame/internal/logging"
+	"wordgame/internal/metrics"
+
+	"gorm.io/gorm"
+)
+
+// Dictionary 는 한 언어의 사전 테이블과 메모리 색인이다.
+type Dictionary struct {
+	db    *gorm.DB
+	lang  *Language
+	index *WordIndex
+}
+
+func (d *Dictionary) Language() *Language {
+	return d.lang
+}
+
+func (d *Dictionary) Normalize(word string) string {
+	return d.lang.Normalize(word)
+}
+
+func (d *Dictionary) IsWordInDB(word string) bool {
+	if d.db == nil {
+		logging.Error(slog.Default(), "database_not_initialized")
+		return false
+	}
+
+	w := strings.TrimSpace(word)
+	normalized := d.Normalize(w)
+
+	if normalized == "" {
+		return false
+	}
+
+	start := time.Now()
+	defer func() {
+		metrics.DictionaryLookupSeconds.Observe(time.Since(start).Seconds())
+	}()
+
+	var result Word
+	res := d.db.Raw(d.lang.lookupQuery, w, normalized).Scan(&result)
+
+	if res.Error != nil {
+		logging.Error(slog.Default(), "dictionary_lookup_failed", "language", d.lang.Code, logging.ErrorKey, res.Error)
+		return false
+	}
+
+	return res.RowsAffected > 0
+}
+
+func (d *Dictionary) GetRandomWordByLength(length int) (string, error) {
+	if d.db == nil {
+		logging.Error(slog.Default(), "database_not_initialized")
+		return "", fmt.Errorf("database is not initialized")
+	}
+
+	var result Word
+	query := fmt.Sprintf("SELECT * FROM %s WHERE LENGTH(word) = ? ORDER BY RANDOM() LIMIT 1", d.lang.Table)
+	if err := d.db.Raw(query, length).Scan(&result).Error; err != nil {
+		return "", err
+	}
+	if result.Word == "" {
+		return "", fmt.Errorf("no %s word with length %d", d.lang.Code, length)
+	}
+
+	return d.Normalize(result.Word), nil
+}
+
+// WordCount 는 색인에 든 단어 수를 센다. 색인이 없으면 false 를 돌려준다.
+func (d *Dictionary) WordCount() (int, bool) {
+	if d.index == nil {
+		return 0, false
+	}
+	return d.index.Len(), true
+}
+
+// CountWordsByKey 는 WordIndex.CountByKey 를 감싼다. 색인이 없으면 false 를 돌려준다.
+func (d *Dictionary) CountWordsByKey(name string, key KeyFunc, value string) (int, bool) {
+	if d.index == nil {
+		return 0, false
+	}
+	return d.index.CountByKey(name, key, value), true
+}
+
+// WordsByKey 는 WordIndex.WordsByKey 를 감싼다. 색인이 없으면 false 를 돌려준다.
+func (d *Dictionary) WordsByKey(name string, key KeyFunc, value string) ([]string, bool) {
+	if d.index == nil {
+		return nil, false
+	}
+	return d.index.WordsByKey(name, key, value), true
+}
+
+func (d *Dictionary) loadIndex() error {
+	if !d.db.Migrator().HasTable(d.lang.Table) {
+		// 번들 사전이 없는 언어는 빈 테이블을 만들어 두고, 운영자가 단어를 채운다.
+		if err := d.db.Table(d.lang.Table).Migrator().CreateTable(&Word{}); err != nil {
+			return err
+		}
+	}
+
+	var words []string
+	if err := d.db.Raw(fmt.Sprintf("SELECT word FROM %s", d.lang.Table)).Scan(&words).Error; err != nil {
+		return err
+	}
+	d.index = newWordIndex(words, d.lang)
+	logging.Info(slog.Default(), "word_index_loaded", "language", d.lang.Code, "words", d.index.Len())
+	return nil
+}
@@ -0,0 +1,37 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupLanguage(t *testing.T) {
+	assert.Equal(t, "en", LookupLanguage(LanguageEnglish).Table, "영어 사전은 별도 테이블을 써야 합니다.")
+	assert.Equal(t, DefaultLanguage, LookupLanguage("xx").Code, "모르는 언어는 기본 언어로 처리해야 합니다.")
+	assert.False(t, IsValidLanguage("xx"))
+}
+
+func TestNormalizeEnglishWord(t *testing.T) {
+	assert.Equal(t, "apple", NormalizeEnglishWord(" Apple "), "영어 단어는 소문자로 바뀌어야 합니다.")
+	assert.Equal(t, "well-known", NormalizeEnglishWord("Well-Known"), "영어 단어의 하이픈은 지우지 않아야 합니다.")
+}
+
+func TestEnglishDictionary(t *testing.T) {
+	dict := dbManager.Dictionary(LanguageEnglish)
+	word := Word{ID: 900001, Word: "Zyzzyva"}
+	assert.NoError(t, dbManager.DB.Table("en").Create(&word).Error, "영어 단어 저장 중 오류가 발생했습니다.")
+	defer dbManager.DB.Table("en").Where("id = ?", word.ID).Delete(&Word{})
+
+	assert.True(t, dict.IsWordInDB("ZYZZYVA"), "영어 사전은 대소문자를 구분하지 않아야 합니다.")
+	assert.False(t, dbManager.IsWordInDB("zyzzyva"), "영어 단어는 한국어 사전에 없어야 합니다.")
+}
+
+func TestCountWordsByKey(t *testing.T) {
+	count, ok := dbManager.Dictionary(LanguageKorean).CountWordsByKey("first", firstRune, "하")
+	assert.True(t, ok, "색인이 로드되어 있어야 합니다.")
+	assert.Positive(t, count, "'하'로 시작하는 단어가 있어야 합니다.")
+
+	_, ok = (&DBManager{}).Dictionary(LanguageKorean).CountWordsByKey("first", firstRune, "하")
+	assert.False(t, ok, "색인이 없으면 false를 반환해야 합니다.")
+}
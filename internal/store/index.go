@@ -0,0 +1,136 @@
+package store
+
+import (
+	"sort"
+	"sync"
+	"unicode/utf8"
+)
+
+// KeyFunc 는 단어에서 색인 키(첫 음절, 끝 두 음절 등)를 뽑는다.
+type KeyFunc func(word string) string
+
+// WordIndex 는 사전 단어를 메모리에 보관한다.
+// 잇기 규칙마다 다른 기준(첫 음절, 끝 두 음절 등)의 색인은 CountByKey 나 WordsByKey 로 처음 찾을 때 만든다.
+// 언어의 최소 길이보다 짧은 단어는 낼 수 없으므로 이어지는 단어로 세지 않는다.
+type WordIndex struct {
+	mu    sync.RWMutex
+	words map[string]bool
+	keyed map[string]*keyedIndex
+
+	normalize func(string) string
+	minLength int
+}
+
+type keyedIndex struct {
+	key   KeyFunc
+	words map[string][]string
+}
+
+func NewWordIndex(words []string) *WordIndex {
+	return newWordIndex(words, LookupLanguage(DefaultLanguage))
+}
+
+func newWordIndex(words []string, lang *Language) *WordIndex {
+	idx := &WordIndex{
+		words:     make(map[string]bool),
+		keyed:     make(map[string]*keyedIndex),
+		normalize: lang.Normalize,
+		minLength: lang.MinWordLength,
+	}
+	for _, w := range words {
+		idx.add(w)
+	}
+	return idx
+}
+
+func (idx *WordIndex) Add(word string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.add(word)
+}
+
+func (idx *WordIndex) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.words)
+}
+
+func (idx *WordIndex) Contains(word string) bool {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return idx.words[idx.normalize(word)]
+}
+
+// WordsOfLength 는 글자 수가 length 인 단어들을 정렬해서 돌려준다.
+func (idx *WordIndex) WordsOfLength(length int) []string {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	var words []string
+	for w := range idx.words {
+		if utf8.RuneCountInString(w) == length {
+			words = append(words, w)
+		}
+	}
+	sort.Strings(words)
+	return words
+}
+
+// CountByKey 는 key 로 뽑은 값이 value 인 단어 수를 센다. name 이 같은 색인은 한 번만 만든다.
+func (idx *WordIndex) CountByKey(name string, key KeyFunc, value string) int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.keyedIndex(name, key).words[value])
+}
+
+// WordsByKey 는 key 로 뽑은 값이 value 인 단어들을 복사해서 돌려준다.
+func (idx *WordIndex) WordsByKey(name string, key KeyFunc, value string) []string {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	words := idx.keyedIndex(name, key).words[value]
+	return append([]string(nil), words...)
+}
+
+// keyedIndex 는 읽기 잠금을 잡은 채로 불린다. 색인이 없으면 잠시 쓰기 잠금으로 바꿔 만든다.
+func (idx *WordIndex) keyedIndex(name string, key KeyFunc) *keyedIndex {
+	if ki, ok := idx.keyed[name]; ok {
+		return ki
+	}
+	idx.mu.RUnlock()
+	defer idx.mu.RLock()
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	ki, ok := idx.keyed[name]
+	if !ok {
+		ki = &keyedIndex{key: key, words: make(map[string][]string)}
+		for w := range idx.words {
+			if !idx.playable(w) {
+				continue
+			}
+			k := key(w)
+			ki.words[k] = append(ki.words[k], w)
+		}
+		idx.keyed[name] = ki
+	}
+	return ki
+}
+
+func (idx *WordIndex) add(word string) {
+	word = idx.normalize(word)
+	if word == "" || idx.words[word] {
+		return
+	}
+	idx.words[word] = true
+	if !idx.playable(word) {
+		return
+	}
+	for _, ki := range idx.keyed {
+		k := ki.key(word)
+		ki.words[k] = append(ki.words[k], word)
+	}
+}
+
+// playable 은 단어가 게임에서 낼 수 있는 길이인지 본다.
+func (idx *WordIndex) playable(word string) bool {
+	return utf8.RuneCountInString(word) >= idx.minLength
+}
@@ -0,0 +1,55 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func firstRune(word string) string {
+	return string([]rune(word)[0])
+}
+
+func TestWordIndexAdd(t *testing.T) {
+	idx := NewWordIndex([]string{"일기"})
+	assert.Zero(t, idx.CountByKey("first", firstRune, "기"), "기로 시작하는 단어가 없어야 합니다.")
+
+	idx.Add("기차")
+	idx.Add("기차")
+
+	assert.Equal(t, 1, idx.CountByKey("first", firstRune, "기"), "추가한 단어가 색인에 반영되어야 합니다.")
+	assert.Equal(t, 2, idx.Len(), "중복 단어는 한 번만 저장되어야 합니다.")
+}
+
+func TestWordIndexSkipsShortWords(t *testing.T) {
+	idx := NewWordIndex([]string{"사과", "과"})
+
+	assert.Zero(t, idx.CountByKey("first", firstRune, "과"), "최소 길이보다 짧은 단어는 이어지는 단어로 세지 않아야 합니다.")
+	assert.True(t, idx.Contains("과"), "짧은 단어도 사전에는 있어야 합니다.")
+
+	english := newWordIndex([]string{"cat", "to"}, LookupLanguage(LanguageEnglish))
+	first := func(word string) string { return word[:1] }
+	assert.Zero(t, english.CountByKey("first", first, "t"), "영어는 세 글자보다 짧은 단어를 세지 않아야 합니다.")
+}
+
+func TestWordIndexCountByKey(t *testing.T) {
+	idx := NewWordIndex([]string{"사과", "과일", "일과"})
+	lastRune := func(word string) string {
+		r := []rune(word)
+		return string(r[len(r)-1])
+	}
+
+	assert.Equal(t, 2, idx.CountByKey("last", lastRune, "과"), "끝 음절이 '과'인 단어는 2개여야 합니다.")
+	assert.Equal(t, 0, idx.CountByKey("last", lastRune, "사"), "끝 음절이 '사'인 단어는 없어야 합니다.")
+
+	idx.Add("기사")
+	assert.Equal(t, 1, idx.CountByKey("last", lastRune, "사"), "추가한 단어가 이미 만든 색인에도 반영되어야 합니다.")
+}
+
+func TestWordIndexWordsByKey(t *testing.T) {
+	idx := NewWordIndex([]string{"사과", "과일", "과자"})
+
+	assert.ElementsMatch(t, []string{"과일", "과자"}, idx.WordsByKey("first", firstRune, "과"), "첫 음절이 '과'인 단어를 모두 돌려줘야 합니다.")
+	assert.Equal(t, 1, idx.CountByKey("first", firstRune, "사"), "같은 색인으로 단어 수도 셀 수 있어야 합니다.")
+	assert.Empty(t, idx.WordsByKey("first", firstRune, "배"), "없는 음절이면 비어 있어야 합니다.")
+}
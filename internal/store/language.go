@@ -0,0 +1,63 @@
+package store
+
+import "strings"
+
+const (
+	LanguageKorean  = "ko"
+	LanguageEnglish = "en"
+
+	DefaultLanguage = LanguageKorean
+)
+
+// Language 는 언어마다 다른 사전 테이블, 정규화 방식, 단어 길이 기준을 묶는다.
+type Language struct {
+	Code               string
+	Table              string
+	MinWordLength      int
+	MinStartWordLength int
+	MaxStartWordLength int
+	StartWord          string // 사전에서 시작 단어를 뽑지 못했을 때 쓰는 단어
+	Normalize          func(word string) string
+	lookupQuery        string
+}
+
+var languages = map[string]*Language{
+	LanguageKorean: {
+		Code:               LanguageKorean,
+		Table:              "kr",
+		MinWordLength:      2,
+		MinStartWordLength: 2,
+		MaxStartWordLength: 6,
+		StartWord:          "사과",
+		Normalize:          NormalizeWord,
+		lookupQuery:        "SELECT * FROM kr WHERE word = ? OR REPLACE(REPLACE(REPLACE(word, '-', ''), '^', ''), ' ', '') = ? LIMIT 1",
+	},
+	LanguageEnglish: {
+		Code:               LanguageEnglish,
+		Table:              "en",
+		MinWordLength:      3,
+		MinStartWordLength: 3,
+		MaxStartWordLength: 8,
+		StartWord:          "apple",
+		Normalize:          NormalizeEnglishWord,
+		lookupQuery:        "SELECT * FROM en WHERE word = ? OR LOWER(word) = ? LIMIT 1",
+	},
+}
+
+func IsValidLanguage(code string) bool {
+	_, ok := languages[code]
+	return ok
+}
+
+// LookupLanguage 는 언어 코드에 맞는 설정을 돌려준다. 모르는 코드면 기본 언어를 돌려준다.
+func LookupLanguage(code string) *Language {
+	if lang, ok := languages[code]; ok {
+		return lang
+	}
+	return languages[DefaultLanguage]
+}
+
+// NormalizeEnglishWord 는 영어 단어의 대소문자를 구분하지 않도록 소문자로 바꾼다.
+func NormalizeEnglishWord(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
@@ -0,0 +1,53 @@
+package store
+
+import "fmt"
+
+// MemoryDictionary 는 DB 없이 단어 목록만으로 만든 사전이다. 테스트와 시뮬레이션에서 쓴다.
+// 메서드는 Dictionary 와 같고, 색인이 항상 있다.
+type MemoryDictionary struct {
+	lang  *Language
+	index *WordIndex
+}
+
+func NewMemoryDictionary(code string, words []string) *MemoryDictionary {
+	lang := LookupLanguage(code)
+	return &MemoryDictionary{lang: lang, index: newWordIndex(words, lang)}
+}
+
+func (d *MemoryDictionary) Language() *Language {
+	return d.lang
+}
+
+func (d *MemoryDictionary) Normalize(word string) string {
+	return d.lang.Normalize(word)
+}
+
+func (d *MemoryDictionary) Add(word string) {
+	d.index.Add(word)
+}
+
+func (d *MemoryDictionary) IsWordInDB(word string) bool {
+	normalized := d.Normalize(word)
+	return normalized != "" && d.index.Contains(normalized)
+}
+
+// GetRandomWordByLength 는 결과가 늘 같도록 길이가 맞는 단어 중 가장 앞 단어를 돌려준다.
+func (d *MemoryDictionary) GetRandomWordByLength(length int) (string, error) {
+	words := d.index.WordsOfLength(length)
+	if len(words) == 0 {
+		return "", fmt.Errorf("no %s word with length %d", d.lang.Code, length)
+	}
+	return words[0], nil
+}
+
+func (d *MemoryDictionary) WordCount() (int, bool) {
+	return d.index.Len(), true
+}
+
+func (d *MemoryDictionary) CountWordsByKey(name string, key KeyFunc, value string) (int, bool) {
+	return d.index.CountByKey(name, key, value), true
+}
+
+func (d *MemoryDictionary) WordsByKey(name string, key KeyFunc, value string) ([]string, bool) {
+	return d.index.WordsByKey(name, key, value), true
+}
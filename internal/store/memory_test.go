@@ -0,0 +1,31 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryDictionary(t *testing.T) {
+	dict := NewMemoryDictionary(LanguageKorean, []string{"사과", "과-일", "과자", "나트륨"})
+
+	assert.True(t, dict.IsWordInDB("과일"), "단어는 정규화해서 저장되어야 합니다.")
+	assert.False(t, dict.IsWordInDB("바나나"), "목록에 없는 단어는 없어야 합니다.")
+	assert.False(t, dict.IsWordInDB(" "), "빈 단어는 없어야 합니다.")
+	count, ok := dict.CountWordsByKey("first", firstRune, "과")
+	assert.True(t, ok)
+	assert.Equal(t, 2, count, "과로 시작하는 단어를 세야 합니다.")
+
+	word, err := dict.GetRandomWordByLength(2)
+	assert.NoError(t, err)
+	assert.Equal(t, "과일", word, "길이가 맞는 단어 중 가장 앞 단어를 돌려줘야 합니다.")
+	_, err = dict.GetRandomWordByLength(5)
+	assert.Error(t, err, "길이가 맞는 단어가 없으면 오류여야 합니다.")
+}
+
+func TestMemoryDictionaryEnglish(t *testing.T) {
+	dict := NewMemoryDictionary(LanguageEnglish, []string{"Apple"})
+
+	assert.True(t, dict.IsWordInDB("APPLE"), "영어 사전은 대소문자를 구분하지 않아야 합니다.")
+	assert.Equal(t, 3, dict.Language().MinWordLength)
+}
@@ -0,0 +1,48 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var ErrGameReplayNotFound = errors.New("game replay not found")
+
+// GameReplay 는 끝난 게임 하나의 기록이다. Events 에는 순서대로 쌓인 이벤트 목록이 JSON 으로 들어간다.
+type GameReplay struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	RoomID    int       `gorm:"index" json:"roomId"`
+	RoomName  string    `json:"roomName"`
+	Events    string    `json:"events"`
+	StartedAt time.Time `json:"startedAt"`
+	EndedAt   time.Time `json:"endedAt"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (GameReplay) TableName() string {
+	return "game_replays"
+}
+
+func (db *DBManager) SaveGameReplay(replay *GameReplay) error {
+	if db.DB == nil {
+		return fmt.Errorf("database is not initialized")
+	}
+	return db.DB.Create(replay).Error
+}
+
+func (db *DBManager) GetGameReplay(id uint) (*GameReplay, error) {
+	if db.DB == nil {
+		return nil, fmt.Errorf("database is not initialized")
+	}
+
+	var replay GameReplay
+	if err := db.DB.First(&replay, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrGameReplayNotFound
+		}
+		return nil, err
+	}
+	return &replay, nil
+}
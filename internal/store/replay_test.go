@@ -0,0 +1,21 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveGameReplay(t *testing.T) {
+	replay := &GameReplay{RoomID: 1234, RoomName: "테스트 방", Events: `[{"seq":1,"type":"start"}]`}
+	err := dbManager.SaveGameReplay(replay)
+	assert.NoError(t, err, "게임 기록 저장 중 오류가 발생했습니다.")
+	defer dbManager.DB.Delete(replay)
+
+	saved, err := dbManager.GetGameReplay(replay.ID)
+	assert.NoError(t, err, "게임 기록 조회 중 오류가 발생했습니다.")
+	assert.Equal(t, replay.Events, saved.Events, "저장한 이벤트가 그대로 조회되어야 합니다.")
+
+	_, err = dbManager.GetGameReplay(0)
+	assert.ErrorIs(t, err, ErrGameReplayNotFound, "없는 기록은 ErrGameReplayNotFound를 반환해야 합니다.")
+}
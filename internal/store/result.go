@@ -0,0 +1,48 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var ErrGameResultNotFound = errors.New("game result not found")
+
+// GameResult 는 끝난 게임의 최종 순위다. Standings 에는 순위 목록이 JSON 으로 들어간다.
+type GameResult struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	RoomID    int       `gorm:"index" json:"roomId"`
+	RoomName  string    `json:"roomName"`
+	Mode      string    `json:"mode"`
+	Winner    string    `json:"winner"`
+	Standings string    `json:"standings"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (GameResult) TableName() string {
+	return "game_results"
+}
+
+func (db *DBManager) SaveGameResult(result *GameResult) error {
+	if db.DB == nil {
+		return fmt.Errorf("database is not initialized")
+	}
+	return db.DB.Create(result).Error
+}
+
+func (db *DBManager) GetGameResult(id uint) (*GameResult, error) {
+	if db.DB == nil {
+		return nil, fmt.Errorf("database is not initialized")
+	}
+
+	var result GameResult
+	if err := db.DB.First(&result, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrGameResultNotFound
+		}
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,21 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveGameResult(t *testing.T) {
+	result := &GameResult{RoomID: 1234, RoomName: "테스트 방", Mode: "score", Winner: "Alice", Standings: "[]"}
+	err := dbManager.SaveGameResult(result)
+	assert.NoError(t, err, "게임 결과 저장 중 오류가 발생했습니다.")
+	defer dbManager.DB.Delete(result)
+
+	saved, err := dbManager.GetGameResult(result.ID)
+	assert.NoError(t, err, "게임 결과 조회 중 오류가 발생했습니다.")
+	assert.Equal(t, "Alice", saved.Winner, "저장한 우승자가 조회되어야 합니다.")
+
+	_, err = dbManager.GetGameResult(0)
+	assert.ErrorIs(t, err, ErrGameResultNotFound, "없는 결과는 ErrGameResultNotFound를 반환해야 합니다.")
+}
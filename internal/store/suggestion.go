@@ -0,0 +1,146 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"wordgame/internal/logging"
+
+	"gorm.io/gorm"
+)
+
+const (
+	SuggestionStatusPending  = "pending"
+	SuggestionStatusApproved = "approved"
+	SuggestionStatusRejected = "rejected"
+)
+
+var (
+	ErrSuggestionNotFound       = errors.New("suggestion not found")
+	ErrSuggestionAlreadyExists  = errors.New("word is already suggested")
+	ErrSuggestionAlreadyInDict  = errors.New("word is already in the dictionary")
+	ErrSuggestionAlreadyHandled = errors.New("suggestion is already reviewed")
+)
+
+// WordSuggestion 은 사전에 없어 탈락한 플레이어가 추가를 요청한 단어다.
+type WordSuggestion struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	Word        string     `gorm:"index" json:"word"`
+	Status      string     `gorm:"index" json:"status"`
+	SuggestedBy string     `json:"suggestedBy"`
+	RoomID      int        `json:"roomId"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	ReviewedAt  *time.Time `json:"reviewedAt"`
+}
+
+func (WordSuggestion) TableName() string {
+	return "word_suggestions"
+}
+
+func (db *DBManager) AddWordSuggestion(word, suggestedBy string, roomID int) (*WordSuggestion, error) {
+	if db.DB == nil {
+		return nil, fmt.Errorf("database is not initialized")
+	}
+
+	word = NormalizeWord(word)
+	if db.IsWordInDB(word) {
+		return nil, ErrSuggestionAlreadyInDict
+	}
+
+	var count int64
+	if err := db.DB.Model(&WordSuggestion{}).
+		Where("word = ? AND status = ?", word, SuggestionStatusPending).
+		Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, ErrSuggestionAlreadyExists
+	}
+
+	suggestion := &WordSuggestion{
+		Word:        word,
+		Status:      SuggestionStatusPending,
+		SuggestedBy: suggestedBy,
+		RoomID:      roomID,
+	}
+	if err := db.DB.Create(suggestion).Error; err != nil {
+		return nil, err
+	}
+	return suggestion, nil
+}
+
+func (db *DBManager) GetWordSuggestions(status string) ([]WordSuggestion, error) {
+	if db.DB == nil {
+		return nil, fmt.Errorf("database is not initialized")
+	}
+
+	suggestions := make([]WordSuggestion, 0)
+	query := db.DB.Order("id")
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Find(&suggestions).Error
+	return suggestions, err
+}
+
+// ApproveWordSuggestion 은 제안된 단어를 사전에 추가하고 메모리 색인도 바로 갱신한다.
+func (db *DBManager) ApproveWordSuggestion(id uint) (*WordSuggestion, error) {
+	suggestion, err := db.reviewWordSuggestion(id, SuggestionStatusApproved, func(tx *gorm.DB, s *WordSuggestion) error {
+		var count int64
+		if err := tx.Model(&Word{}).Where("word = ?", s.Word).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return nil
+		}
+		return tx.Create(&Word{Word: s.Word}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	if db.index != nil {
+		db.index.Add(suggestion.Word)
+	}
+	logging.Info(slog.Default(), "word_suggestion_approved", "word", suggestion.Word)
+	return suggestion, nil
+}
+
+func (db *DBManager) RejectWordSuggestion(id uint) (*WordSuggestion, error) {
+	return db.reviewWordSuggestion(id, SuggestionStatusRejected, nil)
+}
+
+func (db *DBManager) reviewWordSuggestion(id uint, status string, apply func(tx *gorm.DB, s *WordSuggestion) error) (*WordSuggestion, error) {
+	if db.DB == nil {
+		return nil, fmt.Errorf("database is not initialized")
+	}
+
+	var suggestion WordSuggestion
+	err := db.DB.Transaction(func(tx *gorm.DB) error {
+		res := tx.Limit(1).Find(&suggestion, id)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrSuggestionNotFound
+		}
+		if suggestion.Status != SuggestionStatusPending {
+			return ErrSuggestionAlreadyHandled
+		}
+		if apply != nil {
+			if err := apply(tx, &suggestion); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		suggestion.Status = status
+		suggestion.ReviewedAt = &now
+		return tx.Save(&suggestion).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &suggestion, nil
+}
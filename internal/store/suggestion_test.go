@@ -0,0 +1,43 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApproveWordSuggestion(t *testing.T) {
+	word := "쀍쀍제안"
+	suggestion, err := dbManager.AddWordSuggestion(word, "tester", 1)
+	assert.NoError(t, err, "단어 제안 저장 중 오류가 발생했습니다.")
+	defer dbManager.DB.Delete(suggestion)
+	defer dbManager.DB.Where("word = ?", word).Delete(&Word{})
+
+	_, err = dbManager.AddWordSuggestion(word, "tester", 1)
+	assert.ErrorIs(t, err, ErrSuggestionAlreadyExists, "같은 단어는 중복으로 제안할 수 없어야 합니다.")
+
+	pending, err := dbManager.GetWordSuggestions(SuggestionStatusPending)
+	assert.NoError(t, err, "대기 중인 제안 조회 중 오류가 발생했습니다.")
+	assert.NotEmpty(t, pending, "대기 중인 제안이 조회되어야 합니다.")
+
+	approved, err := dbManager.ApproveWordSuggestion(suggestion.ID)
+	assert.NoError(t, err, "단어 제안 승인 중 오류가 발생했습니다.")
+	assert.Equal(t, SuggestionStatusApproved, approved.Status, "승인된 제안의 상태가 바뀌어야 합니다.")
+	assert.NotNil(t, approved.ReviewedAt, "승인 시각이 기록되어야 합니다.")
+	assert.True(t, dbManager.IsWordInDB(word), "승인된 단어는 사전에 추가되어야 합니다.")
+	indexed, _ := dbManager.Dictionary(DefaultLanguage).WordsByKey("first", firstRune, "쀍")
+	assert.Contains(t, indexed, word, "승인된 단어는 재시작 없이 색인에 반영되어야 합니다.")
+
+	_, err = dbManager.RejectWordSuggestion(suggestion.ID)
+	assert.ErrorIs(t, err, ErrSuggestionAlreadyHandled, "이미 검토된 제안은 다시 처리할 수 없어야 합니다.")
+}
+
+func TestAddWordSuggestionAlreadyInDict(t *testing.T) {
+	_, err := dbManager.AddWordSuggestion("하늘", "tester", 1)
+	assert.ErrorIs(t, err, ErrSuggestionAlreadyInDict, "사전에 있는 단어는 제안할 수 없어야 합니다.")
+}
+
+func TestRejectWordSuggestionNotFound(t *testing.T) {
+	_, err := dbManager.RejectWordSuggestion(0)
+	assert.ErrorIs(t, err, ErrSuggestionNotFound, "없는 제안은 ErrSuggestionNotFound를 반환해야 합니다.")
+}
@@ -0,0 +1,119 @@
+package store
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	WordListModeAllow = "allow"
+	WordListModeBlock = "block"
+)
+
+// accountKeyBytes 는 단어 목록 계정 키의 바이트 수다. 16진수로 바꾸면 두 배 길이가 된다.
+const accountKeyBytes = 16
+
+var (
+	ErrWordListNotFound = errors.New("word list not found")
+	ErrUnknownAccount   = errors.New("unknown word list account")
+)
+
+// WordList 는 계정별로 저장해 두고 방을 만들 때 다시 고를 수 있는 단어 목록이다.
+// 계정은 서버가 처음 저장할 때 만들어 준 비밀 키이고, DB에는 키의 해시만 남긴다.
+type WordList struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	Account   string    `gorm:"index" json:"-"`
+	Name      string    `json:"name"`
+	Mode      string    `json:"mode"`
+	Words     string    `json:"-"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (WordList) TableName() string {
+	return "word_lists"
+}
+
+// NewAccountKey 는 새 단어 목록 계정 키를 만든다. 키는 만든 사람에게만 돌려준다.
+func NewAccountKey() (string, error) {
+	b := make([]byte, accountKeyBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func hashAccountKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func NewWordList(accountKey, name, mode string, words []string) *WordList {
+	return &WordList{
+		Account: hashAccountKey(accountKey),
+		Name:    name,
+		Mode:    mode,
+		Words:   strings.Join(words, "\n"),
+	}
+}
+
+func (l *WordList) Entries() []string {
+	if l.Words == "" {
+		return []string{}
+	}
+	return strings.Split(l.Words, "\n")
+}
+
+// OwnedBy 는 목록이 주어진 계정 키로 저장되었는지 본다.
+func (l *WordList) OwnedBy(accountKey string) bool {
+	if accountKey == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(l.Account), []byte(hashAccountKey(accountKey))) == 1
+}
+
+func IsValidWordListMode(mode string) bool {
+	return mode == WordListModeAllow || mode == WordListModeBlock
+}
+
+func (db *DBManager) SaveWordList(list *WordList) error {
+	if db.DB == nil {
+		return fmt.Errorf("database is not initialized")
+	}
+	return db.DB.Create(list).Error
+}
+
+func (db *DBManager) GetWordList(id uint) (*WordList, error) {
+	if db.DB == nil {
+		return nil, fmt.Errorf("database is not initialized")
+	}
+
+	var list WordList
+	res := db.DB.Limit(1).Find(&list, id)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, ErrWordListNotFound
+	}
+	return &list, nil
+}
+
+// GetWordListsByAccount 는 계정 키로 저장한 목록을 모두 돌려준다.
+func (db *DBManager) GetWordListsByAccount(accountKey string) ([]WordList, error) {
+	if db.DB == nil {
+		return nil, fmt.Errorf("database is not initialized")
+	}
+
+	lists := make([]WordList, 0)
+	if accountKey == "" {
+		return lists, nil
+	}
+	err := db.DB.Where("account = ?", hashAccountKey(accountKey)).Order("id").Find(&lists).Error
+	return lists, err
+}
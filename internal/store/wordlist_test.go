@@ -0,0 +1,52 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveAndGetWordList(t *testing.T) {
+	key, err := NewAccountKey()
+	assert.NoError(t, err)
+	list := NewWordList(key, "음식", WordListModeAllow, []string{"김치", "치즈"})
+	assert.NotEqual(t, key, list.Account, "계정 키는 그대로 저장되면 안 됩니다.")
+
+	err = dbManager.SaveWordList(list)
+	assert.NoError(t, err, "단어 목록 저장 중 오류가 발생했습니다.")
+	assert.NotZero(t, list.ID, "저장된 단어 목록에는 ID가 있어야 합니다.")
+	defer dbManager.DB.Delete(list)
+
+	loaded, err := dbManager.GetWordList(list.ID)
+	assert.NoError(t, err, "단어 목록 조회 중 오류가 발생했습니다.")
+	assert.Equal(t, []string{"김치", "치즈"}, loaded.Entries(), "저장한 단어가 그대로 조회되어야 합니다.")
+
+	lists, err := dbManager.GetWordListsByAccount(key)
+	assert.NoError(t, err, "계정별 단어 목록 조회 중 오류가 발생했습니다.")
+	assert.NotEmpty(t, lists, "계정의 단어 목록이 조회되어야 합니다.")
+}
+
+func TestWordListAccountKey(t *testing.T) {
+	key, err := NewAccountKey()
+	assert.NoError(t, err)
+	other, err := NewAccountKey()
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, other, "계정 키는 매번 새로 만들어져야 합니다.")
+
+	list := NewWordList(key, "음식", WordListModeAllow, []string{"김치"})
+	assert.NoError(t, dbManager.SaveWordList(list))
+	defer dbManager.DB.Delete(list)
+
+	assert.True(t, list.OwnedBy(key), "만든 계정 키로는 목록을 쓸 수 있어야 합니다.")
+	assert.False(t, list.OwnedBy(other), "다른 계정 키로는 목록을 쓸 수 없어야 합니다.")
+	assert.False(t, list.OwnedBy(""), "빈 계정 키로는 목록을 쓸 수 없어야 합니다.")
+
+	lists, err := dbManager.GetWordListsByAccount(other)
+	assert.NoError(t, err)
+	assert.Empty(t, lists, "다른 계정의 목록은 조회되면 안 됩니다.")
+}
+
+func TestGetWordListNotFound(t *testing.T) {
+	_, err := dbManager.GetWordList(0)
+	assert.ErrorIs(t, err, ErrWordListNotFound, "없는 단어 목록은 ErrWordListNotFound를 반환해야 합니다.")
+}
@@ -1,36 +1,96 @@
 package main
 
 import (
-	"log"
+	"log/slog"
+	"os"
 
+	"wordgame/internal/config"
 	"wordgame/internal/game"
 	"wordgame/internal/handler"
+	"wordgame/internal/logging"
+	"wordgame/internal/metrics"
 	"wordgame/internal/random"
+	"wordgame/internal/ratelimit"
 	"wordgame/internal/store"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/joho/godotenv"
 )
 
 func main() {
+	envErr := godotenv.Load()
+
+	logger := logging.NewFromEnv()
+	slog.SetDefault(logger)
+	if envErr != nil {
+		logging.Info(logger, "env_file_not_found")
+	}
+
+	// os.Exit 는 defer 를 건너뛰므로, 정리할 것이 있는 서버 실행은 run 에 두고 끝난 뒤에 종료 코드를 정한다.
+	if err := run(logger); err != nil {
+		os.Exit(1)
+	}
+}
+
+func run(logger *slog.Logger) error {
 	app := fiber.New()
 
 	app.Static("/", "./assets/public")
 
+	// RANDOM_SEED 를 주면 같은 순서로 난수를 뽑아 게임 흐름을 다시 만들 수 있다.
 	randomManager := random.NewManager()
-	roomManager := game.NewRoomManager(randomManager)
+	if seed := config.Int("RANDOM_SEED", 0); seed != 0 {
+		randomManager = random.NewSeededManager(int64(seed))
+	}
+	logging.Info(logger, "random_seeded", "seed", randomManager.Seed())
+	roomManager := game.NewRoomManager(randomManager, logger)
+	roomManager.SetLimits(game.Limits{
+		MaxPlayersPerRoom:    config.Int("MAX_PLAYERS_PER_ROOM", game.DefaultMaxPlayersPerRoom),
+		MaxSpectatorsPerRoom: config.Int("MAX_SPECTATORS_PER_ROOM", game.DefaultMaxSpectatorsPerRoom),
+		MaxRooms:             config.Int("MAX_ROOMS", game.DefaultMaxRooms),
+		MaxConnections:       config.Int("MAX_CONNECTIONS", game.DefaultMaxConnections),
+		MaxConnectionsPerIP:  config.Int("MAX_CONNECTIONS_PER_IP", game.DefaultMaxConnectionsPerIP),
+		MessagesPerSecond:    config.Float("WS_MESSAGES_PER_SECOND", game.DefaultMessagesPerSecond),
+		MessageBurst:         config.Int("WS_MESSAGE_BURST", game.DefaultMessageBurst),
+		MaxMessageViolations: config.Int("WS_MAX_MESSAGE_VIOLATIONS", game.DefaultMaxMessageViolations),
+	})
+	roomManager.StartJanitor(game.JanitorConfig{
+		Interval:        config.Duration("ROOM_JANITOR_INTERVAL", game.DefaultJanitorInterval),
+		UnusedRoomTTL:   config.Duration("UNUSED_ROOM_TTL", game.DefaultUnusedRoomTTL),
+		IdleRoomTTL:     config.Duration("IDLE_ROOM_TTL", game.DefaultIdleRoomTTL),
+		FinishedRoomTTL: config.Duration("FINISHED_ROOM_TTL", game.DefaultFinishedRoomTTL),
+	})
+	defer roomManager.StopJanitor()
+
 	dbManager, err := store.NewDBManager()
 	if err != nil {
-		log.Fatalf("Failed to initialize database manager: %v", err)
+		logging.Error(logger, "database_init_failed", logging.ErrorKey, err)
+		return err
 	}
 
-	apiHandler := handler.NewAPIHandler(roomManager, dbManager)
+	rateLimitHandler := handler.NewRateLimitHandler(
+		ratelimit.New(config.APIRateLimit()),
+		ratelimit.New(config.RoomCreateRateLimit()),
+		logger,
+	)
+	rateLimitHandler.RegisterRoutes(app)
+
+	apiHandler := handler.NewAPIHandler(roomManager, dbManager, logger)
 	apiHandler.RegisterRoutes(app)
 
-	wsHandler := handler.NewWSHandler(roomManager)
+	adminHandler := handler.NewAdminHandler(roomManager, dbManager, os.Getenv("ADMIN_TOKEN"), logger)
+	adminHandler.RegisterRoutes(app)
+
+	metricsHandler := handler.NewMetricsHandler(metrics.Default)
+	metricsHandler.RegisterRoutes(app)
+
+	wsHandler := handler.NewWSHandler(roomManager, dbManager, logger)
 	wsHandler.RegisterRoutes(app)
 
-	log.Println("listening on :3000")
+	logging.Info(logger, "server_listening", "addr", ":3000")
 	if err := app.Listen(":3000"); err != nil {
-		log.Fatal(err)
+		logging.Error(logger, "server_failed", logging.ErrorKey, err)
+		return err
 	}
+	return nil
 }